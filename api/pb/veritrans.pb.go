@@ -151,8 +151,9 @@ type AccountRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AccountID string                    `protobuf:"bytes,1,opt,name=accountID,proto3" json:"accountID,omitempty"`
-	CardParam *AccountRequest_CardParam `protobuf:"bytes,2,opt,name=cardParam,proto3,oneof" json:"cardParam,omitempty"`
+	AccountID         string                            `protobuf:"bytes,1,opt,name=accountID,proto3" json:"accountID,omitempty"`
+	CardParam         *AccountRequest_CardParam         `protobuf:"bytes,2,opt,name=cardParam,proto3,oneof" json:"cardParam,omitempty"`
+	AccountBasicParam *AccountRequest_AccountBasicParam `protobuf:"bytes,3,opt,name=accountBasicParam,proto3,oneof" json:"accountBasicParam,omitempty"`
 }
 
 func (x *AccountRequest) Reset() {
@@ -201,6 +202,13 @@ func (x *AccountRequest) GetCardParam() *AccountRequest_CardParam {
 	return nil
 }
 
+func (x *AccountRequest) GetAccountBasicParam() *AccountRequest_AccountBasicParam {
+	if x != nil {
+		return x.AccountBasicParam
+	}
+	return nil
+}
+
 type AccountReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -256,20 +264,22 @@ func (x *AccountReply) GetErr() string {
 	return ""
 }
 
-type PaymentRequest struct {
+type RecurringChargeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderID       string                        `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
-	Amount        string                        `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Jpo           *string                       `protobuf:"bytes,3,opt,name=jpo,proto3,oneof" json:"jpo,omitempty"`
-	WithCapture   *string                       `protobuf:"bytes,4,opt,name=withCapture,proto3,oneof" json:"withCapture,omitempty"`
-	PayNowIDParam *PaymentRequest_PayNowIDParam `protobuf:"bytes,5,opt,name=payNowIDParam,proto3,oneof" json:"payNowIDParam,omitempty"`
+	AccountID     string  `protobuf:"bytes,1,opt,name=accountID,proto3" json:"accountID,omitempty"`
+	GroupID       string  `protobuf:"bytes,2,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	StartDate     *string `protobuf:"bytes,3,opt,name=startDate,proto3,oneof" json:"startDate,omitempty"`
+	EndDate       *string `protobuf:"bytes,4,opt,name=endDate,proto3,oneof" json:"endDate,omitempty"`
+	FinalCharge   *string `protobuf:"bytes,5,opt,name=finalCharge,proto3,oneof" json:"finalCharge,omitempty"`
+	OneTimeAmount *string `protobuf:"bytes,6,opt,name=oneTimeAmount,proto3,oneof" json:"oneTimeAmount,omitempty"`
+	Amount        *string `protobuf:"bytes,7,opt,name=amount,proto3,oneof" json:"amount,omitempty"`
 }
 
-func (x *PaymentRequest) Reset() {
-	*x = PaymentRequest{}
+func (x *RecurringChargeRequest) Reset() {
+	*x = RecurringChargeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_veritrans_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -277,13 +287,13 @@ func (x *PaymentRequest) Reset() {
 	}
 }
 
-func (x *PaymentRequest) String() string {
+func (x *RecurringChargeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaymentRequest) ProtoMessage() {}
+func (*RecurringChargeRequest) ProtoMessage() {}
 
-func (x *PaymentRequest) ProtoReflect() protoreflect.Message {
+func (x *RecurringChargeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_veritrans_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -295,56 +305,72 @@ func (x *PaymentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaymentRequest.ProtoReflect.Descriptor instead.
-func (*PaymentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RecurringChargeRequest.ProtoReflect.Descriptor instead.
+func (*RecurringChargeRequest) Descriptor() ([]byte, []int) {
 	return file_veritrans_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *PaymentRequest) GetOrderID() string {
+func (x *RecurringChargeRequest) GetAccountID() string {
 	if x != nil {
-		return x.OrderID
+		return x.AccountID
 	}
 	return ""
 }
 
-func (x *PaymentRequest) GetAmount() string {
+func (x *RecurringChargeRequest) GetGroupID() string {
 	if x != nil {
-		return x.Amount
+		return x.GroupID
 	}
 	return ""
 }
 
-func (x *PaymentRequest) GetJpo() string {
-	if x != nil && x.Jpo != nil {
-		return *x.Jpo
+func (x *RecurringChargeRequest) GetStartDate() string {
+	if x != nil && x.StartDate != nil {
+		return *x.StartDate
 	}
 	return ""
 }
 
-func (x *PaymentRequest) GetWithCapture() string {
-	if x != nil && x.WithCapture != nil {
-		return *x.WithCapture
+func (x *RecurringChargeRequest) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
 	}
 	return ""
 }
 
-func (x *PaymentRequest) GetPayNowIDParam() *PaymentRequest_PayNowIDParam {
-	if x != nil {
-		return x.PayNowIDParam
+func (x *RecurringChargeRequest) GetFinalCharge() string {
+	if x != nil && x.FinalCharge != nil {
+		return *x.FinalCharge
 	}
-	return nil
+	return ""
 }
 
-type PaymentReply struct {
+func (x *RecurringChargeRequest) GetOneTimeAmount() string {
+	if x != nil && x.OneTimeAmount != nil {
+		return *x.OneTimeAmount
+	}
+	return ""
+}
+
+func (x *RecurringChargeRequest) GetAmount() string {
+	if x != nil && x.Amount != nil {
+		return *x.Amount
+	}
+	return ""
+}
+
+type RecurringChargeReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Err string `protobuf:"bytes,1,opt,name=err,proto3" json:"err,omitempty"`
+	AccountID        string                                  `protobuf:"bytes,1,opt,name=accountID,proto3" json:"accountID,omitempty"`
+	RecurringCharges []*RecurringChargeReply_RecurringCharge `protobuf:"bytes,2,rep,name=recurringCharges,proto3" json:"recurringCharges,omitempty"`
+	Err              string                                  `protobuf:"bytes,3,opt,name=err,proto3" json:"err,omitempty"`
 }
 
-func (x *PaymentReply) Reset() {
-	*x = PaymentReply{}
+func (x *RecurringChargeReply) Reset() {
+	*x = RecurringChargeReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_veritrans_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -352,13 +378,13 @@ func (x *PaymentReply) Reset() {
 	}
 }
 
-func (x *PaymentReply) String() string {
+func (x *RecurringChargeReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaymentReply) ProtoMessage() {}
+func (*RecurringChargeReply) ProtoMessage() {}
 
-func (x *PaymentReply) ProtoReflect() protoreflect.Message {
+func (x *RecurringChargeReply) ProtoReflect() protoreflect.Message {
 	mi := &file_veritrans_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -370,31 +396,60 @@ func (x *PaymentReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaymentReply.ProtoReflect.Descriptor instead.
-func (*PaymentReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use RecurringChargeReply.ProtoReflect.Descriptor instead.
+func (*RecurringChargeReply) Descriptor() ([]byte, []int) {
 	return file_veritrans_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *PaymentReply) GetErr() string {
+func (x *RecurringChargeReply) GetAccountID() string {
+	if x != nil {
+		return x.AccountID
+	}
+	return ""
+}
+
+func (x *RecurringChargeReply) GetRecurringCharges() []*RecurringChargeReply_RecurringCharge {
+	if x != nil {
+		return x.RecurringCharges
+	}
+	return nil
+}
+
+func (x *RecurringChargeReply) GetErr() string {
 	if x != nil {
 		return x.Err
 	}
 	return ""
 }
 
-type AccountRequest_CardParam struct {
+type PaymentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CardNumber  *string `protobuf:"bytes,1,opt,name=cardNumber,proto3,oneof" json:"cardNumber,omitempty"`
-	CardExpire  *string `protobuf:"bytes,2,opt,name=cardExpire,proto3,oneof" json:"cardExpire,omitempty"`
-	DefaultCard *string `protobuf:"bytes,3,opt,name=defaultCard,proto3,oneof" json:"defaultCard,omitempty"`
-	CardID      *string `protobuf:"bytes,4,opt,name=cardID,proto3,oneof" json:"cardID,omitempty"`
+	OrderID string `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	Amount  string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Deprecated: Do not use.
+	Jpo           *string                       `protobuf:"bytes,3,opt,name=jpo,proto3,oneof" json:"jpo,omitempty"`
+	WithCapture   *string                       `protobuf:"bytes,4,opt,name=withCapture,proto3,oneof" json:"withCapture,omitempty"`
+	PayNowIDParam *PaymentRequest_PayNowIDParam `protobuf:"bytes,5,opt,name=payNowIDParam,proto3,oneof" json:"payNowIDParam,omitempty"`
+	Currency      *string                       `protobuf:"bytes,6,opt,name=currency,proto3,oneof" json:"currency,omitempty"`
+	ServiceType   *string                       `protobuf:"bytes,7,opt,name=serviceType,proto3,oneof" json:"serviceType,omitempty"`
+	// Types that are assignable to ServiceParams:
+	//	*PaymentRequest_Cvs
+	//	*PaymentRequest_Bank
+	//	*PaymentRequest_Mpi
+	//	*PaymentRequest_Paypal
+	//	*PaymentRequest_Foreign
+	//	*PaymentRequest_Carrier
+	//	*PaymentRequest_Em
+	//	*PaymentRequest_Saison
+	ServiceParams isPaymentRequest_ServiceParams `protobuf_oneof:"serviceParams"`
+	PaymentOption *PaymentOption                 `protobuf:"bytes,16,opt,name=paymentOption,proto3,oneof" json:"paymentOption,omitempty"`
 }
 
-func (x *AccountRequest_CardParam) Reset() {
-	*x = AccountRequest_CardParam{}
+func (x *PaymentRequest) Reset() {
+	*x = PaymentRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_veritrans_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -402,13 +457,13 @@ func (x *AccountRequest_CardParam) Reset() {
 	}
 }
 
-func (x *AccountRequest_CardParam) String() string {
+func (x *PaymentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccountRequest_CardParam) ProtoMessage() {}
+func (*PaymentRequest) ProtoMessage() {}
 
-func (x *AccountRequest_CardParam) ProtoReflect() protoreflect.Message {
+func (x *PaymentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_veritrans_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -420,50 +475,194 @@ func (x *AccountRequest_CardParam) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AccountRequest_CardParam.ProtoReflect.Descriptor instead.
-func (*AccountRequest_CardParam) Descriptor() ([]byte, []int) {
-	return file_veritrans_proto_rawDescGZIP(), []int{2, 0}
+// Deprecated: Use PaymentRequest.ProtoReflect.Descriptor instead.
+func (*PaymentRequest) Descriptor() ([]byte, []int) {
+	return file_veritrans_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *AccountRequest_CardParam) GetCardNumber() string {
-	if x != nil && x.CardNumber != nil {
-		return *x.CardNumber
+func (x *PaymentRequest) GetOrderID() string {
+	if x != nil {
+		return x.OrderID
 	}
 	return ""
 }
 
-func (x *AccountRequest_CardParam) GetCardExpire() string {
-	if x != nil && x.CardExpire != nil {
-		return *x.CardExpire
+func (x *PaymentRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
 	}
 	return ""
 }
 
-func (x *AccountRequest_CardParam) GetDefaultCard() string {
-	if x != nil && x.DefaultCard != nil {
-		return *x.DefaultCard
+// Deprecated: Do not use.
+func (x *PaymentRequest) GetJpo() string {
+	if x != nil && x.Jpo != nil {
+		return *x.Jpo
 	}
 	return ""
 }
 
-func (x *AccountRequest_CardParam) GetCardID() string {
-	if x != nil && x.CardID != nil {
-		return *x.CardID
+func (x *PaymentRequest) GetWithCapture() string {
+	if x != nil && x.WithCapture != nil {
+		return *x.WithCapture
 	}
 	return ""
 }
 
-type AccountReply_AccountInfo struct {
+func (x *PaymentRequest) GetPayNowIDParam() *PaymentRequest_PayNowIDParam {
+	if x != nil {
+		return x.PayNowIDParam
+	}
+	return nil
+}
+
+func (x *PaymentRequest) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
+}
+
+func (x *PaymentRequest) GetServiceType() string {
+	if x != nil && x.ServiceType != nil {
+		return *x.ServiceType
+	}
+	return ""
+}
+
+func (m *PaymentRequest) GetServiceParams() isPaymentRequest_ServiceParams {
+	if m != nil {
+		return m.ServiceParams
+	}
+	return nil
+}
+
+func (x *PaymentRequest) GetCvs() *CVSPaymentRequest {
+	if x, ok := x.GetServiceParams().(*PaymentRequest_Cvs); ok {
+		return x.Cvs
+	}
+	return nil
+}
+
+func (x *PaymentRequest) GetBank() *BankPaymentRequest {
+	if x, ok := x.GetServiceParams().(*PaymentRequest_Bank); ok {
+		return x.Bank
+	}
+	return nil
+}
+
+func (x *PaymentRequest) GetMpi() *MPIPaymentRequest {
+	if x, ok := x.GetServiceParams().(*PaymentRequest_Mpi); ok {
+		return x.Mpi
+	}
+	return nil
+}
+
+func (x *PaymentRequest) GetPaypal() *PaypalPaymentRequest {
+	if x, ok := x.GetServiceParams().(*PaymentRequest_Paypal); ok {
+		return x.Paypal
+	}
+	return nil
+}
+
+func (x *PaymentRequest) GetForeign() *ForeignPaymentRequest {
+	if x, ok := x.GetServiceParams().(*PaymentRequest_Foreign); ok {
+		return x.Foreign
+	}
+	return nil
+}
+
+func (x *PaymentRequest) GetCarrier() *CarrierPaymentRequest {
+	if x, ok := x.GetServiceParams().(*PaymentRequest_Carrier); ok {
+		return x.Carrier
+	}
+	return nil
+}
+
+func (x *PaymentRequest) GetEm() *EMPaymentRequest {
+	if x, ok := x.GetServiceParams().(*PaymentRequest_Em); ok {
+		return x.Em
+	}
+	return nil
+}
+
+func (x *PaymentRequest) GetSaison() *SaisonPaymentRequest {
+	if x, ok := x.GetServiceParams().(*PaymentRequest_Saison); ok {
+		return x.Saison
+	}
+	return nil
+}
+
+func (x *PaymentRequest) GetPaymentOption() *PaymentOption {
+	if x != nil {
+		return x.PaymentOption
+	}
+	return nil
+}
+
+type isPaymentRequest_ServiceParams interface {
+	isPaymentRequest_ServiceParams()
+}
+
+type PaymentRequest_Cvs struct {
+	Cvs *CVSPaymentRequest `protobuf:"bytes,8,opt,name=cvs,proto3,oneof"`
+}
+
+type PaymentRequest_Bank struct {
+	Bank *BankPaymentRequest `protobuf:"bytes,9,opt,name=bank,proto3,oneof"`
+}
+
+type PaymentRequest_Mpi struct {
+	Mpi *MPIPaymentRequest `protobuf:"bytes,10,opt,name=mpi,proto3,oneof"`
+}
+
+type PaymentRequest_Paypal struct {
+	Paypal *PaypalPaymentRequest `protobuf:"bytes,11,opt,name=paypal,proto3,oneof"`
+}
+
+type PaymentRequest_Foreign struct {
+	Foreign *ForeignPaymentRequest `protobuf:"bytes,12,opt,name=foreign,proto3,oneof"`
+}
+
+type PaymentRequest_Carrier struct {
+	Carrier *CarrierPaymentRequest `protobuf:"bytes,13,opt,name=carrier,proto3,oneof"`
+}
+
+type PaymentRequest_Em struct {
+	Em *EMPaymentRequest `protobuf:"bytes,14,opt,name=em,proto3,oneof"`
+}
+
+type PaymentRequest_Saison struct {
+	Saison *SaisonPaymentRequest `protobuf:"bytes,15,opt,name=saison,proto3,oneof"`
+}
+
+func (*PaymentRequest_Cvs) isPaymentRequest_ServiceParams() {}
+
+func (*PaymentRequest_Bank) isPaymentRequest_ServiceParams() {}
+
+func (*PaymentRequest_Mpi) isPaymentRequest_ServiceParams() {}
+
+func (*PaymentRequest_Paypal) isPaymentRequest_ServiceParams() {}
+
+func (*PaymentRequest_Foreign) isPaymentRequest_ServiceParams() {}
+
+func (*PaymentRequest_Carrier) isPaymentRequest_ServiceParams() {}
+
+func (*PaymentRequest_Em) isPaymentRequest_ServiceParams() {}
+
+func (*PaymentRequest_Saison) isPaymentRequest_ServiceParams() {}
+
+type PaymentOption struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AccountID string                               `protobuf:"bytes,1,opt,name=accountID,proto3" json:"accountID,omitempty"`
-	CardInfo  []*AccountReply_AccountInfo_CardInfo `protobuf:"bytes,2,rep,name=cardInfo,proto3" json:"cardInfo,omitempty"`
+	Type             string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	InstallmentCount *int32 `protobuf:"varint,2,opt,name=installmentCount,proto3,oneof" json:"installmentCount,omitempty"`
 }
 
-func (x *AccountReply_AccountInfo) Reset() {
-	*x = AccountReply_AccountInfo{}
+func (x *PaymentOption) Reset() {
+	*x = PaymentOption{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_veritrans_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -471,13 +670,13 @@ func (x *AccountReply_AccountInfo) Reset() {
 	}
 }
 
-func (x *AccountReply_AccountInfo) String() string {
+func (x *PaymentOption) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccountReply_AccountInfo) ProtoMessage() {}
+func (*PaymentOption) ProtoMessage() {}
 
-func (x *AccountReply_AccountInfo) ProtoReflect() protoreflect.Message {
+func (x *PaymentOption) ProtoReflect() protoreflect.Message {
 	mi := &file_veritrans_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -489,38 +688,40 @@ func (x *AccountReply_AccountInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AccountReply_AccountInfo.ProtoReflect.Descriptor instead.
-func (*AccountReply_AccountInfo) Descriptor() ([]byte, []int) {
-	return file_veritrans_proto_rawDescGZIP(), []int{3, 0}
+// Deprecated: Use PaymentOption.ProtoReflect.Descriptor instead.
+func (*PaymentOption) Descriptor() ([]byte, []int) {
+	return file_veritrans_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *AccountReply_AccountInfo) GetAccountID() string {
+func (x *PaymentOption) GetType() string {
 	if x != nil {
-		return x.AccountID
+		return x.Type
 	}
 	return ""
 }
 
-func (x *AccountReply_AccountInfo) GetCardInfo() []*AccountReply_AccountInfo_CardInfo {
-	if x != nil {
-		return x.CardInfo
+func (x *PaymentOption) GetInstallmentCount() int32 {
+	if x != nil && x.InstallmentCount != nil {
+		return *x.InstallmentCount
 	}
-	return nil
+	return 0
 }
 
-type AccountReply_AccountInfo_CardInfo struct {
+type TokenPaymentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CardID      string `protobuf:"bytes,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
-	CardExpire  string `protobuf:"bytes,2,opt,name=cardExpire,proto3" json:"cardExpire,omitempty"`
-	CardNumber  string `protobuf:"bytes,3,opt,name=cardNumber,proto3" json:"cardNumber,omitempty"`
-	DefaultCard string `protobuf:"bytes,4,opt,name=defaultCard,proto3" json:"defaultCard,omitempty"`
+	OrderID       string         `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	Amount        string         `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Token         string         `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	AccountID     *string        `protobuf:"bytes,4,opt,name=accountID,proto3,oneof" json:"accountID,omitempty"`
+	WithCapture   *string        `protobuf:"bytes,5,opt,name=withCapture,proto3,oneof" json:"withCapture,omitempty"`
+	PaymentOption *PaymentOption `protobuf:"bytes,6,opt,name=paymentOption,proto3,oneof" json:"paymentOption,omitempty"`
 }
 
-func (x *AccountReply_AccountInfo_CardInfo) Reset() {
-	*x = AccountReply_AccountInfo_CardInfo{}
+func (x *TokenPaymentRequest) Reset() {
+	*x = TokenPaymentRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_veritrans_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -528,13 +729,13 @@ func (x *AccountReply_AccountInfo_CardInfo) Reset() {
 	}
 }
 
-func (x *AccountReply_AccountInfo_CardInfo) String() string {
+func (x *TokenPaymentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccountReply_AccountInfo_CardInfo) ProtoMessage() {}
+func (*TokenPaymentRequest) ProtoMessage() {}
 
-func (x *AccountReply_AccountInfo_CardInfo) ProtoReflect() protoreflect.Message {
+func (x *TokenPaymentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_veritrans_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -546,50 +747,70 @@ func (x *AccountReply_AccountInfo_CardInfo) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AccountReply_AccountInfo_CardInfo.ProtoReflect.Descriptor instead.
-func (*AccountReply_AccountInfo_CardInfo) Descriptor() ([]byte, []int) {
-	return file_veritrans_proto_rawDescGZIP(), []int{3, 0, 0}
+// Deprecated: Use TokenPaymentRequest.ProtoReflect.Descriptor instead.
+func (*TokenPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_veritrans_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *AccountReply_AccountInfo_CardInfo) GetCardID() string {
+func (x *TokenPaymentRequest) GetOrderID() string {
 	if x != nil {
-		return x.CardID
+		return x.OrderID
 	}
 	return ""
 }
 
-func (x *AccountReply_AccountInfo_CardInfo) GetCardExpire() string {
+func (x *TokenPaymentRequest) GetAmount() string {
 	if x != nil {
-		return x.CardExpire
+		return x.Amount
 	}
 	return ""
 }
 
-func (x *AccountReply_AccountInfo_CardInfo) GetCardNumber() string {
+func (x *TokenPaymentRequest) GetToken() string {
 	if x != nil {
-		return x.CardNumber
+		return x.Token
 	}
 	return ""
 }
 
-func (x *AccountReply_AccountInfo_CardInfo) GetDefaultCard() string {
-	if x != nil {
-		return x.DefaultCard
+func (x *TokenPaymentRequest) GetAccountID() string {
+	if x != nil && x.AccountID != nil {
+		return *x.AccountID
 	}
 	return ""
 }
 
-type PaymentRequest_PayNowIDParam struct {
+func (x *TokenPaymentRequest) GetWithCapture() string {
+	if x != nil && x.WithCapture != nil {
+		return *x.WithCapture
+	}
+	return ""
+}
+
+func (x *TokenPaymentRequest) GetPaymentOption() *PaymentOption {
+	if x != nil {
+		return x.PaymentOption
+	}
+	return nil
+}
+
+type ReAuthorizeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AccountParam *PaymentRequest_PayNowIDParam_AccountParam `protobuf:"bytes,1,opt,name=accountParam,proto3,oneof" json:"accountParam,omitempty"`
-	Token        string                                     `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	OrderID         string `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	OriginalOrderID string `protobuf:"bytes,2,opt,name=originalOrderID,proto3" json:"originalOrderID,omitempty"`
+	Amount          string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Deprecated: Do not use.
+	Jpo            *string        `protobuf:"bytes,4,opt,name=jpo,proto3,oneof" json:"jpo,omitempty"`
+	WithCapture    *string        `protobuf:"bytes,5,opt,name=withCapture,proto3,oneof" json:"withCapture,omitempty"`
+	CancelOriginal *bool          `protobuf:"varint,6,opt,name=cancelOriginal,proto3,oneof" json:"cancelOriginal,omitempty"`
+	PaymentOption  *PaymentOption `protobuf:"bytes,7,opt,name=paymentOption,proto3,oneof" json:"paymentOption,omitempty"`
 }
 
-func (x *PaymentRequest_PayNowIDParam) Reset() {
-	*x = PaymentRequest_PayNowIDParam{}
+func (x *ReAuthorizeRequest) Reset() {
+	*x = ReAuthorizeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_veritrans_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -597,13 +818,13 @@ func (x *PaymentRequest_PayNowIDParam) Reset() {
 	}
 }
 
-func (x *PaymentRequest_PayNowIDParam) String() string {
+func (x *ReAuthorizeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaymentRequest_PayNowIDParam) ProtoMessage() {}
+func (*ReAuthorizeRequest) ProtoMessage() {}
 
-func (x *PaymentRequest_PayNowIDParam) ProtoReflect() protoreflect.Message {
+func (x *ReAuthorizeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_veritrans_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -615,35 +836,78 @@ func (x *PaymentRequest_PayNowIDParam) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaymentRequest_PayNowIDParam.ProtoReflect.Descriptor instead.
-func (*PaymentRequest_PayNowIDParam) Descriptor() ([]byte, []int) {
-	return file_veritrans_proto_rawDescGZIP(), []int{4, 0}
+// Deprecated: Use ReAuthorizeRequest.ProtoReflect.Descriptor instead.
+func (*ReAuthorizeRequest) Descriptor() ([]byte, []int) {
+	return file_veritrans_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *PaymentRequest_PayNowIDParam) GetAccountParam() *PaymentRequest_PayNowIDParam_AccountParam {
+func (x *ReAuthorizeRequest) GetOrderID() string {
 	if x != nil {
-		return x.AccountParam
+		return x.OrderID
 	}
-	return nil
+	return ""
 }
 
-func (x *PaymentRequest_PayNowIDParam) GetToken() string {
+func (x *ReAuthorizeRequest) GetOriginalOrderID() string {
 	if x != nil {
-		return x.Token
+		return x.OriginalOrderID
 	}
 	return ""
 }
 
-type PaymentRequest_PayNowIDParam_AccountParam struct {
+func (x *ReAuthorizeRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+// Deprecated: Do not use.
+func (x *ReAuthorizeRequest) GetJpo() string {
+	if x != nil && x.Jpo != nil {
+		return *x.Jpo
+	}
+	return ""
+}
+
+func (x *ReAuthorizeRequest) GetWithCapture() string {
+	if x != nil && x.WithCapture != nil {
+		return *x.WithCapture
+	}
+	return ""
+}
+
+func (x *ReAuthorizeRequest) GetCancelOriginal() bool {
+	if x != nil && x.CancelOriginal != nil {
+		return *x.CancelOriginal
+	}
+	return false
+}
+
+func (x *ReAuthorizeRequest) GetPaymentOption() *PaymentOption {
+	if x != nil {
+		return x.PaymentOption
+	}
+	return nil
+}
+
+type CVSPaymentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AccountID string `protobuf:"bytes,1,opt,name=accountID,proto3" json:"accountID,omitempty"`
+	OrderID           string  `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	Amount            string  `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	ServiceOptionType string  `protobuf:"bytes,3,opt,name=serviceOptionType,proto3" json:"serviceOptionType,omitempty"`
+	Name1             string  `protobuf:"bytes,4,opt,name=name1,proto3" json:"name1,omitempty"`
+	Name2             *string `protobuf:"bytes,5,opt,name=name2,proto3,oneof" json:"name2,omitempty"`
+	TelNo             string  `protobuf:"bytes,6,opt,name=telNo,proto3" json:"telNo,omitempty"`
+	PayLimit          string  `protobuf:"bytes,7,opt,name=payLimit,proto3" json:"payLimit,omitempty"`
+	PayLimitHhmm      *string `protobuf:"bytes,8,opt,name=payLimitHhmm,proto3,oneof" json:"payLimitHhmm,omitempty"`
 }
 
-func (x *PaymentRequest_PayNowIDParam_AccountParam) Reset() {
-	*x = PaymentRequest_PayNowIDParam_AccountParam{}
+func (x *CVSPaymentRequest) Reset() {
+	*x = CVSPaymentRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_veritrans_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -651,32 +915,2023 @@ func (x *PaymentRequest_PayNowIDParam_AccountParam) Reset() {
 	}
 }
 
-func (x *PaymentRequest_PayNowIDParam_AccountParam) String() string {
+func (x *CVSPaymentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaymentRequest_PayNowIDParam_AccountParam) ProtoMessage() {}
+func (*CVSPaymentRequest) ProtoMessage() {}
+
+func (x *CVSPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_veritrans_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CVSPaymentRequest.ProtoReflect.Descriptor instead.
+func (*CVSPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_veritrans_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CVSPaymentRequest) GetOrderID() string {
+	if x != nil {
+		return x.OrderID
+	}
+	return ""
+}
+
+func (x *CVSPaymentRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *CVSPaymentRequest) GetServiceOptionType() string {
+	if x != nil {
+		return x.ServiceOptionType
+	}
+	return ""
+}
+
+func (x *CVSPaymentRequest) GetName1() string {
+	if x != nil {
+		return x.Name1
+	}
+	return ""
+}
+
+func (x *CVSPaymentRequest) GetName2() string {
+	if x != nil && x.Name2 != nil {
+		return *x.Name2
+	}
+	return ""
+}
+
+func (x *CVSPaymentRequest) GetTelNo() string {
+	if x != nil {
+		return x.TelNo
+	}
+	return ""
+}
+
+func (x *CVSPaymentRequest) GetPayLimit() string {
+	if x != nil {
+		return x.PayLimit
+	}
+	return ""
+}
+
+func (x *CVSPaymentRequest) GetPayLimitHhmm() string {
+	if x != nil && x.PayLimitHhmm != nil {
+		return *x.PayLimitHhmm
+	}
+	return ""
+}
+
+type BankPaymentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderID           string  `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	Amount            string  `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	ServiceOptionType string  `protobuf:"bytes,3,opt,name=serviceOptionType,proto3" json:"serviceOptionType,omitempty"`
+	Name1             string  `protobuf:"bytes,4,opt,name=name1,proto3" json:"name1,omitempty"`
+	Name2             string  `protobuf:"bytes,5,opt,name=name2,proto3" json:"name2,omitempty"`
+	Kana1             string  `protobuf:"bytes,6,opt,name=kana1,proto3" json:"kana1,omitempty"`
+	Kana2             string  `protobuf:"bytes,7,opt,name=kana2,proto3" json:"kana2,omitempty"`
+	TelNo             *string `protobuf:"bytes,8,opt,name=telNo,proto3,oneof" json:"telNo,omitempty"`
+	PayLimit          string  `protobuf:"bytes,9,opt,name=payLimit,proto3" json:"payLimit,omitempty"`
+	Contents          *string `protobuf:"bytes,10,opt,name=contents,proto3,oneof" json:"contents,omitempty"`
+	ContentsKana      *string `protobuf:"bytes,11,opt,name=contentsKana,proto3,oneof" json:"contentsKana,omitempty"`
+}
+
+func (x *BankPaymentRequest) Reset() {
+	*x = BankPaymentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_veritrans_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BankPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BankPaymentRequest) ProtoMessage() {}
+
+func (x *BankPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_veritrans_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BankPaymentRequest.ProtoReflect.Descriptor instead.
+func (*BankPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_veritrans_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BankPaymentRequest) GetOrderID() string {
+	if x != nil {
+		return x.OrderID
+	}
+	return ""
+}
+
+func (x *BankPaymentRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *BankPaymentRequest) GetServiceOptionType() string {
+	if x != nil {
+		return x.ServiceOptionType
+	}
+	return ""
+}
+
+func (x *BankPaymentRequest) GetName1() string {
+	if x != nil {
+		return x.Name1
+	}
+	return ""
+}
+
+func (x *BankPaymentRequest) GetName2() string {
+	if x != nil {
+		return x.Name2
+	}
+	return ""
+}
+
+func (x *BankPaymentRequest) GetKana1() string {
+	if x != nil {
+		return x.Kana1
+	}
+	return ""
+}
+
+func (x *BankPaymentRequest) GetKana2() string {
+	if x != nil {
+		return x.Kana2
+	}
+	return ""
+}
+
+func (x *BankPaymentRequest) GetTelNo() string {
+	if x != nil && x.TelNo != nil {
+		return *x.TelNo
+	}
+	return ""
+}
+
+func (x *BankPaymentRequest) GetPayLimit() string {
+	if x != nil {
+		return x.PayLimit
+	}
+	return ""
+}
+
+func (x *BankPaymentRequest) GetContents() string {
+	if x != nil && x.Contents != nil {
+		return *x.Contents
+	}
+	return ""
+}
+
+func (x *BankPaymentRequest) GetContentsKana() string {
+	if x != nil && x.ContentsKana != nil {
+		return *x.ContentsKana
+	}
+	return ""
+}
+
+type MPIPaymentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderID string `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	Amount  string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Deprecated: Do not use.
+	Jpo               *string                       `protobuf:"bytes,3,opt,name=jpo,proto3,oneof" json:"jpo,omitempty"`
+	WithCapture       *string                       `protobuf:"bytes,4,opt,name=withCapture,proto3,oneof" json:"withCapture,omitempty"`
+	PayNowIDParam     *PaymentRequest_PayNowIDParam `protobuf:"bytes,5,opt,name=payNowIDParam,proto3" json:"payNowIDParam,omitempty"`
+	ServiceOptionType string                        `protobuf:"bytes,6,opt,name=serviceOptionType,proto3" json:"serviceOptionType,omitempty"`
+	RedirectionURI    string                        `protobuf:"bytes,7,opt,name=redirectionURI,proto3" json:"redirectionURI,omitempty"`
+	HttpUserAgent     *string                       `protobuf:"bytes,8,opt,name=httpUserAgent,proto3,oneof" json:"httpUserAgent,omitempty"`
+	HttpAccept        *string                       `protobuf:"bytes,9,opt,name=httpAccept,proto3,oneof" json:"httpAccept,omitempty"`
+	PaymentOption     *PaymentOption                `protobuf:"bytes,10,opt,name=paymentOption,proto3,oneof" json:"paymentOption,omitempty"`
+}
+
+func (x *MPIPaymentRequest) Reset() {
+	*x = MPIPaymentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_veritrans_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MPIPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MPIPaymentRequest) ProtoMessage() {}
+
+func (x *MPIPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_veritrans_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MPIPaymentRequest.ProtoReflect.Descriptor instead.
+func (*MPIPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_veritrans_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MPIPaymentRequest) GetOrderID() string {
+	if x != nil {
+		return x.OrderID
+	}
+	return ""
+}
+
+func (x *MPIPaymentRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+// Deprecated: Do not use.
+func (x *MPIPaymentRequest) GetJpo() string {
+	if x != nil && x.Jpo != nil {
+		return *x.Jpo
+	}
+	return ""
+}
+
+func (x *MPIPaymentRequest) GetWithCapture() string {
+	if x != nil && x.WithCapture != nil {
+		return *x.WithCapture
+	}
+	return ""
+}
+
+func (x *MPIPaymentRequest) GetPayNowIDParam() *PaymentRequest_PayNowIDParam {
+	if x != nil {
+		return x.PayNowIDParam
+	}
+	return nil
+}
+
+func (x *MPIPaymentRequest) GetServiceOptionType() string {
+	if x != nil {
+		return x.ServiceOptionType
+	}
+	return ""
+}
+
+func (x *MPIPaymentRequest) GetRedirectionURI() string {
+	if x != nil {
+		return x.RedirectionURI
+	}
+	return ""
+}
+
+func (x *MPIPaymentRequest) GetHttpUserAgent() string {
+	if x != nil && x.HttpUserAgent != nil {
+		return *x.HttpUserAgent
+	}
+	return ""
+}
+
+func (x *MPIPaymentRequest) GetHttpAccept() string {
+	if x != nil && x.HttpAccept != nil {
+		return *x.HttpAccept
+	}
+	return ""
+}
+
+func (x *MPIPaymentRequest) GetPaymentOption() *PaymentOption {
+	if x != nil {
+		return x.PaymentOption
+	}
+	return nil
+}
+
+type PaypalPaymentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderID     string  `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	Amount      string  `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	WithCapture *string `protobuf:"bytes,3,opt,name=withCapture,proto3,oneof" json:"withCapture,omitempty"`
+	ReturnURL   string  `protobuf:"bytes,4,opt,name=returnURL,proto3" json:"returnURL,omitempty"`
+	CancelURL   string  `protobuf:"bytes,5,opt,name=cancelURL,proto3" json:"cancelURL,omitempty"`
+}
+
+func (x *PaypalPaymentRequest) Reset() {
+	*x = PaypalPaymentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_veritrans_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaypalPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaypalPaymentRequest) ProtoMessage() {}
+
+func (x *PaypalPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_veritrans_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaypalPaymentRequest.ProtoReflect.Descriptor instead.
+func (*PaypalPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_veritrans_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PaypalPaymentRequest) GetOrderID() string {
+	if x != nil {
+		return x.OrderID
+	}
+	return ""
+}
+
+func (x *PaypalPaymentRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *PaypalPaymentRequest) GetWithCapture() string {
+	if x != nil && x.WithCapture != nil {
+		return *x.WithCapture
+	}
+	return ""
+}
+
+func (x *PaypalPaymentRequest) GetReturnURL() string {
+	if x != nil {
+		return x.ReturnURL
+	}
+	return ""
+}
+
+func (x *PaypalPaymentRequest) GetCancelURL() string {
+	if x != nil {
+		return x.CancelURL
+	}
+	return ""
+}
+
+type ForeignPaymentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderID       string  `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	Amount        string  `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      *string `protobuf:"bytes,3,opt,name=currency,proto3,oneof" json:"currency,omitempty"`
+	WithCapture   *string `protobuf:"bytes,4,opt,name=withCapture,proto3,oneof" json:"withCapture,omitempty"`
+	CommodityName string  `protobuf:"bytes,5,opt,name=commodityName,proto3" json:"commodityName,omitempty"`
+	ReturnURL     string  `protobuf:"bytes,6,opt,name=returnURL,proto3" json:"returnURL,omitempty"`
+}
+
+func (x *ForeignPaymentRequest) Reset() {
+	*x = ForeignPaymentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_veritrans_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForeignPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForeignPaymentRequest) ProtoMessage() {}
+
+func (x *ForeignPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_veritrans_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForeignPaymentRequest.ProtoReflect.Descriptor instead.
+func (*ForeignPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_veritrans_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ForeignPaymentRequest) GetOrderID() string {
+	if x != nil {
+		return x.OrderID
+	}
+	return ""
+}
+
+func (x *ForeignPaymentRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *ForeignPaymentRequest) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
+}
+
+func (x *ForeignPaymentRequest) GetWithCapture() string {
+	if x != nil && x.WithCapture != nil {
+		return *x.WithCapture
+	}
+	return ""
+}
+
+func (x *ForeignPaymentRequest) GetCommodityName() string {
+	if x != nil {
+		return x.CommodityName
+	}
+	return ""
+}
+
+func (x *ForeignPaymentRequest) GetReturnURL() string {
+	if x != nil {
+		return x.ReturnURL
+	}
+	return ""
+}
+
+type CarrierPaymentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderID           string  `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	Amount            string  `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	WithCapture       *string `protobuf:"bytes,3,opt,name=withCapture,proto3,oneof" json:"withCapture,omitempty"`
+	ServiceOptionType string  `protobuf:"bytes,4,opt,name=serviceOptionType,proto3" json:"serviceOptionType,omitempty"`
+	TerminalKind      string  `protobuf:"bytes,5,opt,name=terminalKind,proto3" json:"terminalKind,omitempty"`
+	ItemType          string  `protobuf:"bytes,6,opt,name=itemType,proto3" json:"itemType,omitempty"`
+	SuccessURL        string  `protobuf:"bytes,7,opt,name=successURL,proto3" json:"successURL,omitempty"`
+	CancelURL         string  `protobuf:"bytes,8,opt,name=cancelURL,proto3" json:"cancelURL,omitempty"`
+	ErrorURL          string  `protobuf:"bytes,9,opt,name=errorURL,proto3" json:"errorURL,omitempty"`
+}
+
+func (x *CarrierPaymentRequest) Reset() {
+	*x = CarrierPaymentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_veritrans_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CarrierPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CarrierPaymentRequest) ProtoMessage() {}
+
+func (x *CarrierPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_veritrans_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CarrierPaymentRequest.ProtoReflect.Descriptor instead.
+func (*CarrierPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_veritrans_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CarrierPaymentRequest) GetOrderID() string {
+	if x != nil {
+		return x.OrderID
+	}
+	return ""
+}
+
+func (x *CarrierPaymentRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *CarrierPaymentRequest) GetWithCapture() string {
+	if x != nil && x.WithCapture != nil {
+		return *x.WithCapture
+	}
+	return ""
+}
+
+func (x *CarrierPaymentRequest) GetServiceOptionType() string {
+	if x != nil {
+		return x.ServiceOptionType
+	}
+	return ""
+}
+
+func (x *CarrierPaymentRequest) GetTerminalKind() string {
+	if x != nil {
+		return x.TerminalKind
+	}
+	return ""
+}
+
+func (x *CarrierPaymentRequest) GetItemType() string {
+	if x != nil {
+		return x.ItemType
+	}
+	return ""
+}
+
+func (x *CarrierPaymentRequest) GetSuccessURL() string {
+	if x != nil {
+		return x.SuccessURL
+	}
+	return ""
+}
+
+func (x *CarrierPaymentRequest) GetCancelURL() string {
+	if x != nil {
+		return x.CancelURL
+	}
+	return ""
+}
+
+func (x *CarrierPaymentRequest) GetErrorURL() string {
+	if x != nil {
+		return x.ErrorURL
+	}
+	return ""
+}
+
+type EMPaymentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderID           string  `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	Amount            string  `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	ServiceOptionType string  `protobuf:"bytes,3,opt,name=serviceOptionType,proto3" json:"serviceOptionType,omitempty"`
+	MailAddr          *string `protobuf:"bytes,4,opt,name=mailAddr,proto3,oneof" json:"mailAddr,omitempty"`
+	SuccessURL        *string `protobuf:"bytes,5,opt,name=successURL,proto3,oneof" json:"successURL,omitempty"`
+	CancelURL         *string `protobuf:"bytes,6,opt,name=cancelURL,proto3,oneof" json:"cancelURL,omitempty"`
+	ErrorURL          *string `protobuf:"bytes,7,opt,name=errorURL,proto3,oneof" json:"errorURL,omitempty"`
+}
+
+func (x *EMPaymentRequest) Reset() {
+	*x = EMPaymentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_veritrans_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EMPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EMPaymentRequest) ProtoMessage() {}
+
+func (x *EMPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_veritrans_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EMPaymentRequest.ProtoReflect.Descriptor instead.
+func (*EMPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_veritrans_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *EMPaymentRequest) GetOrderID() string {
+	if x != nil {
+		return x.OrderID
+	}
+	return ""
+}
+
+func (x *EMPaymentRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *EMPaymentRequest) GetServiceOptionType() string {
+	if x != nil {
+		return x.ServiceOptionType
+	}
+	return ""
+}
+
+func (x *EMPaymentRequest) GetMailAddr() string {
+	if x != nil && x.MailAddr != nil {
+		return *x.MailAddr
+	}
+	return ""
+}
+
+func (x *EMPaymentRequest) GetSuccessURL() string {
+	if x != nil && x.SuccessURL != nil {
+		return *x.SuccessURL
+	}
+	return ""
+}
+
+func (x *EMPaymentRequest) GetCancelURL() string {
+	if x != nil && x.CancelURL != nil {
+		return *x.CancelURL
+	}
+	return ""
+}
+
+func (x *EMPaymentRequest) GetErrorURL() string {
+	if x != nil && x.ErrorURL != nil {
+		return *x.ErrorURL
+	}
+	return ""
+}
+
+type SaisonPaymentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderID     string `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	Amount      string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	CardAmount  string `protobuf:"bytes,3,opt,name=cardAmount,proto3" json:"cardAmount,omitempty"`
+	PointAmount string `protobuf:"bytes,4,opt,name=pointAmount,proto3" json:"pointAmount,omitempty"`
+	// Deprecated: Do not use.
+	Jpo           *string                       `protobuf:"bytes,5,opt,name=jpo,proto3,oneof" json:"jpo,omitempty"`
+	WithCapture   *string                       `protobuf:"bytes,6,opt,name=withCapture,proto3,oneof" json:"withCapture,omitempty"`
+	PayNowIDParam *PaymentRequest_PayNowIDParam `protobuf:"bytes,7,opt,name=payNowIDParam,proto3" json:"payNowIDParam,omitempty"`
+	PaymentOption *PaymentOption                `protobuf:"bytes,8,opt,name=paymentOption,proto3,oneof" json:"paymentOption,omitempty"`
+}
+
+func (x *SaisonPaymentRequest) Reset() {
+	*x = SaisonPaymentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_veritrans_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaisonPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaisonPaymentRequest) ProtoMessage() {}
+
+func (x *SaisonPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_veritrans_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaisonPaymentRequest.ProtoReflect.Descriptor instead.
+func (*SaisonPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_veritrans_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SaisonPaymentRequest) GetOrderID() string {
+	if x != nil {
+		return x.OrderID
+	}
+	return ""
+}
+
+func (x *SaisonPaymentRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *SaisonPaymentRequest) GetCardAmount() string {
+	if x != nil {
+		return x.CardAmount
+	}
+	return ""
+}
+
+func (x *SaisonPaymentRequest) GetPointAmount() string {
+	if x != nil {
+		return x.PointAmount
+	}
+	return ""
+}
+
+// Deprecated: Do not use.
+func (x *SaisonPaymentRequest) GetJpo() string {
+	if x != nil && x.Jpo != nil {
+		return *x.Jpo
+	}
+	return ""
+}
+
+func (x *SaisonPaymentRequest) GetWithCapture() string {
+	if x != nil && x.WithCapture != nil {
+		return *x.WithCapture
+	}
+	return ""
+}
+
+func (x *SaisonPaymentRequest) GetPayNowIDParam() *PaymentRequest_PayNowIDParam {
+	if x != nil {
+		return x.PayNowIDParam
+	}
+	return nil
+}
+
+func (x *SaisonPaymentRequest) GetPaymentOption() *PaymentOption {
+	if x != nil {
+		return x.PaymentOption
+	}
+	return nil
+}
+
+type PaymentReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Err    string                          `protobuf:"bytes,1,opt,name=err,proto3" json:"err,omitempty"`
+	Result *PaymentReply_TransactionResult `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *PaymentReply) Reset() {
+	*x = PaymentReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_veritrans_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaymentReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentReply) ProtoMessage() {}
+
+func (x *PaymentReply) ProtoReflect() protoreflect.Message {
+	mi := &file_veritrans_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentReply.ProtoReflect.Descriptor instead.
+func (*PaymentReply) Descriptor() ([]byte, []int) {
+	return file_veritrans_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PaymentReply) GetErr() string {
+	if x != nil {
+		return x.Err
+	}
+	return ""
+}
+
+func (x *PaymentReply) GetResult() *PaymentReply_TransactionResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type SearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderID      *string  `protobuf:"bytes,1,opt,name=orderID,proto3,oneof" json:"orderID,omitempty"`
+	AccountID    *string  `protobuf:"bytes,2,opt,name=accountID,proto3,oneof" json:"accountID,omitempty"`
+	From         *string  `protobuf:"bytes,3,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	To           *string  `protobuf:"bytes,4,opt,name=to,proto3,oneof" json:"to,omitempty"`
+	ServiceTypes []string `protobuf:"bytes,5,rep,name=serviceTypes,proto3" json:"serviceTypes,omitempty"`
+	NewerFlag    *string  `protobuf:"bytes,6,opt,name=newerFlag,proto3,oneof" json:"newerFlag,omitempty"`
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_veritrans_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_veritrans_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_veritrans_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SearchRequest) GetOrderID() string {
+	if x != nil && x.OrderID != nil {
+		return *x.OrderID
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetAccountID() string {
+	if x != nil && x.AccountID != nil {
+		return *x.AccountID
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetFrom() string {
+	if x != nil && x.From != nil {
+		return *x.From
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetTo() string {
+	if x != nil && x.To != nil {
+		return *x.To
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetServiceTypes() []string {
+	if x != nil {
+		return x.ServiceTypes
+	}
+	return nil
+}
+
+func (x *SearchRequest) GetNewerFlag() string {
+	if x != nil && x.NewerFlag != nil {
+		return *x.NewerFlag
+	}
+	return ""
+}
+
+type SearchReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderInfo []*SearchReply_OrderInfo `protobuf:"bytes,1,rep,name=orderInfo,proto3" json:"orderInfo,omitempty"`
+	Err       string                   `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
+}
+
+func (x *SearchReply) Reset() {
+	*x = SearchReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_veritrans_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchReply) ProtoMessage() {}
+
+func (x *SearchReply) ProtoReflect() protoreflect.Message {
+	mi := &file_veritrans_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchReply.ProtoReflect.Descriptor instead.
+func (*SearchReply) Descriptor() ([]byte, []int) {
+	return file_veritrans_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SearchReply) GetOrderInfo() []*SearchReply_OrderInfo {
+	if x != nil {
+		return x.OrderInfo
+	}
+	return nil
+}
+
+func (x *SearchReply) GetErr() string {
+	if x != nil {
+		return x.Err
+	}
+	return ""
+}
+
+type AccountRequest_CardParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CardNumber  *string `protobuf:"bytes,1,opt,name=cardNumber,proto3,oneof" json:"cardNumber,omitempty"`
+	CardExpire  *string `protobuf:"bytes,2,opt,name=cardExpire,proto3,oneof" json:"cardExpire,omitempty"`
+	DefaultCard *string `protobuf:"bytes,3,opt,name=defaultCard,proto3,oneof" json:"defaultCard,omitempty"`
+	CardID      *string `protobuf:"bytes,4,opt,name=cardID,proto3,oneof" json:"cardID,omitempty"`
+}
+
+func (x *AccountRequest_CardParam) Reset() {
+	*x = AccountRequest_CardParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_veritrans_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountRequest_CardParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountRequest_CardParam) ProtoMessage() {}
+
+func (x *AccountRequest_CardParam) ProtoReflect() protoreflect.Message {
+	mi := &file_veritrans_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountRequest_CardParam.ProtoReflect.Descriptor instead.
+func (*AccountRequest_CardParam) Descriptor() ([]byte, []int) {
+	return file_veritrans_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *AccountRequest_CardParam) GetCardNumber() string {
+	if x != nil && x.CardNumber != nil {
+		return *x.CardNumber
+	}
+	return ""
+}
+
+func (x *AccountRequest_CardParam) GetCardExpire() string {
+	if x != nil && x.CardExpire != nil {
+		return *x.CardExpire
+	}
+	return ""
+}
+
+func (x *AccountRequest_CardParam) GetDefaultCard() string {
+	if x != nil && x.DefaultCard != nil {
+		return *x.DefaultCard
+	}
+	return ""
+}
+
+func (x *AccountRequest_CardParam) GetCardID() string {
+	if x != nil && x.CardID != nil {
+		return *x.CardID
+	}
+	return ""
+}
+
+type AccountRequest_AccountBasicParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeleteDate      *string `protobuf:"bytes,1,opt,name=deleteDate,proto3,oneof" json:"deleteDate,omitempty"`
+	ForceDeleteDate *string `protobuf:"bytes,2,opt,name=forceDeleteDate,proto3,oneof" json:"forceDeleteDate,omitempty"`
+}
+
+func (x *AccountRequest_AccountBasicParam) Reset() {
+	*x = AccountRequest_AccountBasicParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_veritrans_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountRequest_AccountBasicParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountRequest_AccountBasicParam) ProtoMessage() {}
+
+func (x *AccountRequest_AccountBasicParam) ProtoReflect() protoreflect.Message {
+	mi := &file_veritrans_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountRequest_AccountBasicParam.ProtoReflect.Descriptor instead.
+func (*AccountRequest_AccountBasicParam) Descriptor() ([]byte, []int) {
+	return file_veritrans_proto_rawDescGZIP(), []int{2, 1}
+}
+
+func (x *AccountRequest_AccountBasicParam) GetDeleteDate() string {
+	if x != nil && x.DeleteDate != nil {
+		return *x.DeleteDate
+	}
+	return ""
+}
+
+func (x *AccountRequest_AccountBasicParam) GetForceDeleteDate() string {
+	if x != nil && x.ForceDeleteDate != nil {
+		return *x.ForceDeleteDate
+	}
+	return ""
+}
+
+type AccountReply_AccountInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountID        string                                     `protobuf:"bytes,1,opt,name=accountID,proto3" json:"accountID,omitempty"`
+	CardInfo         []*AccountReply_AccountInfo_CardInfo       `protobuf:"bytes,2,rep,name=cardInfo,proto3" json:"cardInfo,omitempty"`
+	AccountBasicInfo *AccountReply_AccountInfo_AccountBasicInfo `protobuf:"bytes,3,opt,name=accountBasicInfo,proto3,oneof" json:"accountBasicInfo,omitempty"`
+}
+
+func (x *AccountReply_AccountInfo) Reset() {
+	*x = AccountReply_AccountInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_veritrans_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountReply_AccountInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountReply_AccountInfo) ProtoMessage() {}
+
+func (x *AccountReply_AccountInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_veritrans_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountReply_AccountInfo.ProtoReflect.Descriptor instead.
+func (*AccountReply_AccountInfo) Descriptor() ([]byte, []int) {
+	return file_veritrans_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *AccountReply_AccountInfo) GetAccountID() string {
+	if x != nil {
+		return x.AccountID
+	}
+	return ""
+}
+
+func (x *AccountReply_AccountInfo) GetCardInfo() []*AccountReply_AccountInfo_CardInfo {
+	if x != nil {
+		return x.CardInfo
+	}
+	return nil
+}
+
+func (x *AccountReply_AccountInfo) GetAccountBasicInfo() *AccountReply_AccountInfo_AccountBasicInfo {
+	if x != nil {
+		return x.AccountBasicInfo
+	}
+	return nil
+}
+
+type AccountReply_AccountInfo_CardInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CardID      string `protobuf:"bytes,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	CardExpire  string `protobuf:"bytes,2,opt,name=cardExpire,proto3" json:"cardExpire,omitempty"`
+	CardNumber  string `protobuf:"bytes,3,opt,name=cardNumber,proto3" json:"cardNumber,omitempty"`
+	DefaultCard string `protobuf:"bytes,4,opt,name=defaultCard,proto3" json:"defaultCard,omitempty"`
+}
+
+func (x *AccountReply_AccountInfo_CardInfo) Reset() {
+	*x = AccountReply_AccountInfo_CardInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_veritrans_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountReply_AccountInfo_CardInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountReply_AccountInfo_CardInfo) ProtoMessage() {}
+
+func (x *AccountReply_AccountInfo_CardInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_veritrans_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountReply_AccountInfo_CardInfo.ProtoReflect.Descriptor instead.
+func (*AccountReply_AccountInfo_CardInfo) Descriptor() ([]byte, []int) {
+	return file_veritrans_proto_rawDescGZIP(), []int{3, 0, 0}
+}
+
+func (x *AccountReply_AccountInfo_CardInfo) GetCardID() string {
+	if x != nil {
+		return x.CardID
+	}
+	return ""
+}
+
+func (x *AccountReply_AccountInfo_CardInfo) GetCardExpire() string {
+	if x != nil {
+		return x.CardExpire
+	}
+	return ""
+}
+
+func (x *AccountReply_AccountInfo_CardInfo) GetCardNumber() string {
+	if x != nil {
+		return x.CardNumber
+	}
+	return ""
+}
+
+func (x *AccountReply_AccountInfo_CardInfo) GetDefaultCard() string {
+	if x != nil {
+		return x.DefaultCard
+	}
+	return ""
+}
+
+type AccountReply_AccountInfo_AccountBasicInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreateDate      string `protobuf:"bytes,1,opt,name=createDate,proto3" json:"createDate,omitempty"`
+	DeleteDate      string `protobuf:"bytes,2,opt,name=deleteDate,proto3" json:"deleteDate,omitempty"`
+	ForceDeleteDate string `protobuf:"bytes,3,opt,name=forceDeleteDate,proto3" json:"forceDeleteDate,omitempty"`
+}
+
+func (x *AccountReply_AccountInfo_AccountBasicInfo) Reset() {
+	*x = AccountReply_AccountInfo_AccountBasicInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_veritrans_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountReply_AccountInfo_AccountBasicInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountReply_AccountInfo_AccountBasicInfo) ProtoMessage() {}
+
+func (x *AccountReply_AccountInfo_AccountBasicInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_veritrans_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountReply_AccountInfo_AccountBasicInfo.ProtoReflect.Descriptor instead.
+func (*AccountReply_AccountInfo_AccountBasicInfo) Descriptor() ([]byte, []int) {
+	return file_veritrans_proto_rawDescGZIP(), []int{3, 0, 1}
+}
+
+func (x *AccountReply_AccountInfo_AccountBasicInfo) GetCreateDate() string {
+	if x != nil {
+		return x.CreateDate
+	}
+	return ""
+}
+
+func (x *AccountReply_AccountInfo_AccountBasicInfo) GetDeleteDate() string {
+	if x != nil {
+		return x.DeleteDate
+	}
+	return ""
+}
+
+func (x *AccountReply_AccountInfo_AccountBasicInfo) GetForceDeleteDate() string {
+	if x != nil {
+		return x.ForceDeleteDate
+	}
+	return ""
+}
+
+type RecurringChargeReply_RecurringCharge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID       string `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	StartDate     string `protobuf:"bytes,2,opt,name=startDate,proto3" json:"startDate,omitempty"`
+	EndDate       string `protobuf:"bytes,3,opt,name=endDate,proto3" json:"endDate,omitempty"`
+	FinalCharge   string `protobuf:"bytes,4,opt,name=finalCharge,proto3" json:"finalCharge,omitempty"`
+	OneTimeAmount string `protobuf:"bytes,5,opt,name=oneTimeAmount,proto3" json:"oneTimeAmount,omitempty"`
+	Amount        string `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *RecurringChargeReply_RecurringCharge) Reset() {
+	*x = RecurringChargeReply_RecurringCharge{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_veritrans_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecurringChargeReply_RecurringCharge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecurringChargeReply_RecurringCharge) ProtoMessage() {}
+
+func (x *RecurringChargeReply_RecurringCharge) ProtoReflect() protoreflect.Message {
+	mi := &file_veritrans_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecurringChargeReply_RecurringCharge.ProtoReflect.Descriptor instead.
+func (*RecurringChargeReply_RecurringCharge) Descriptor() ([]byte, []int) {
+	return file_veritrans_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *RecurringChargeReply_RecurringCharge) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *RecurringChargeReply_RecurringCharge) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *RecurringChargeReply_RecurringCharge) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *RecurringChargeReply_RecurringCharge) GetFinalCharge() string {
+	if x != nil {
+		return x.FinalCharge
+	}
+	return ""
+}
+
+func (x *RecurringChargeReply_RecurringCharge) GetOneTimeAmount() string {
+	if x != nil {
+		return x.OneTimeAmount
+	}
+	return ""
+}
+
+func (x *RecurringChargeReply_RecurringCharge) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type PaymentRequest_PayNowIDParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountParam *PaymentRequest_PayNowIDParam_AccountParam `protobuf:"bytes,1,opt,name=accountParam,proto3,oneof" json:"accountParam,omitempty"`
+	Token        string                                     `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *PaymentRequest_PayNowIDParam) Reset() {
+	*x = PaymentRequest_PayNowIDParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_veritrans_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaymentRequest_PayNowIDParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentRequest_PayNowIDParam) ProtoMessage() {}
+
+func (x *PaymentRequest_PayNowIDParam) ProtoReflect() protoreflect.Message {
+	mi := &file_veritrans_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentRequest_PayNowIDParam.ProtoReflect.Descriptor instead.
+func (*PaymentRequest_PayNowIDParam) Descriptor() ([]byte, []int) {
+	return file_veritrans_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *PaymentRequest_PayNowIDParam) GetAccountParam() *PaymentRequest_PayNowIDParam_AccountParam {
+	if x != nil {
+		return x.AccountParam
+	}
+	return nil
+}
+
+func (x *PaymentRequest_PayNowIDParam) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type PaymentRequest_PayNowIDParam_AccountParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountID string `protobuf:"bytes,1,opt,name=accountID,proto3" json:"accountID,omitempty"`
+}
+
+func (x *PaymentRequest_PayNowIDParam_AccountParam) Reset() {
+	*x = PaymentRequest_PayNowIDParam_AccountParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_veritrans_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaymentRequest_PayNowIDParam_AccountParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentRequest_PayNowIDParam_AccountParam) ProtoMessage() {}
+
+func (x *PaymentRequest_PayNowIDParam_AccountParam) ProtoReflect() protoreflect.Message {
+	mi := &file_veritrans_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentRequest_PayNowIDParam_AccountParam.ProtoReflect.Descriptor instead.
+func (*PaymentRequest_PayNowIDParam_AccountParam) Descriptor() ([]byte, []int) {
+	return file_veritrans_proto_rawDescGZIP(), []int{6, 0, 0}
+}
+
+func (x *PaymentRequest_PayNowIDParam_AccountParam) GetAccountID() string {
+	if x != nil {
+		return x.AccountID
+	}
+	return ""
+}
+
+type PaymentReply_TransactionResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VResultCode         string  `protobuf:"bytes,1,opt,name=vResultCode,proto3" json:"vResultCode,omitempty"`
+	Mstatus             string  `protobuf:"bytes,2,opt,name=mstatus,proto3" json:"mstatus,omitempty"`
+	OrderID             string  `protobuf:"bytes,3,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	TxnID               string  `protobuf:"bytes,4,opt,name=txnID,proto3" json:"txnID,omitempty"`
+	Amount              string  `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	TxnDateTime         string  `protobuf:"bytes,6,opt,name=txnDateTime,proto3" json:"txnDateTime,omitempty"`
+	ServiceType         string  `protobuf:"bytes,7,opt,name=serviceType,proto3" json:"serviceType,omitempty"`
+	CardTransactionType string  `protobuf:"bytes,8,opt,name=cardTransactionType,proto3" json:"cardTransactionType,omitempty"`
+	ReceiptNo           *string `protobuf:"bytes,9,opt,name=receiptNo,proto3,oneof" json:"receiptNo,omitempty"`
+	HaraikomiURL        *string `protobuf:"bytes,10,opt,name=haraikomiURL,proto3,oneof" json:"haraikomiURL,omitempty"`
+	ShunoKikanNo        *string `protobuf:"bytes,11,opt,name=shunoKikanNo,proto3,oneof" json:"shunoKikanNo,omitempty"`
+	CustomerNo          *string `protobuf:"bytes,12,opt,name=customerNo,proto3,oneof" json:"customerNo,omitempty"`
+	ConfirmNo           *string `protobuf:"bytes,13,opt,name=confirmNo,proto3,oneof" json:"confirmNo,omitempty"`
+	BillPattern         *string `protobuf:"bytes,14,opt,name=billPattern,proto3,oneof" json:"billPattern,omitempty"`
+	Url                 *string `protobuf:"bytes,15,opt,name=url,proto3,oneof" json:"url,omitempty"`
+	ResResponseContents *string `protobuf:"bytes,16,opt,name=resResponseContents,proto3,oneof" json:"resResponseContents,omitempty"`
+	LoginURL            *string `protobuf:"bytes,17,opt,name=loginURL,proto3,oneof" json:"loginURL,omitempty"`
+	RedirectURL         *string `protobuf:"bytes,18,opt,name=redirectURL,proto3,oneof" json:"redirectURL,omitempty"`
+	CarrierOrderID      *string `protobuf:"bytes,19,opt,name=carrierOrderID,proto3,oneof" json:"carrierOrderID,omitempty"`
+	AppURL              *string `protobuf:"bytes,20,opt,name=appURL,proto3,oneof" json:"appURL,omitempty"`
+	MailURL             *string `protobuf:"bytes,21,opt,name=mailURL,proto3,oneof" json:"mailURL,omitempty"`
+	RefundableAmount    *string `protobuf:"bytes,22,opt,name=refundableAmount,proto3,oneof" json:"refundableAmount,omitempty"`
+}
+
+func (x *PaymentReply_TransactionResult) Reset() {
+	*x = PaymentReply_TransactionResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_veritrans_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaymentReply_TransactionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentReply_TransactionResult) ProtoMessage() {}
+
+func (x *PaymentReply_TransactionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_veritrans_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentReply_TransactionResult.ProtoReflect.Descriptor instead.
+func (*PaymentReply_TransactionResult) Descriptor() ([]byte, []int) {
+	return file_veritrans_proto_rawDescGZIP(), []int{18, 0}
+}
+
+func (x *PaymentReply_TransactionResult) GetVResultCode() string {
+	if x != nil {
+		return x.VResultCode
+	}
+	return ""
+}
+
+func (x *PaymentReply_TransactionResult) GetMstatus() string {
+	if x != nil {
+		return x.Mstatus
+	}
+	return ""
+}
+
+func (x *PaymentReply_TransactionResult) GetOrderID() string {
+	if x != nil {
+		return x.OrderID
+	}
+	return ""
+}
+
+func (x *PaymentReply_TransactionResult) GetTxnID() string {
+	if x != nil {
+		return x.TxnID
+	}
+	return ""
+}
+
+func (x *PaymentReply_TransactionResult) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *PaymentReply_TransactionResult) GetTxnDateTime() string {
+	if x != nil {
+		return x.TxnDateTime
+	}
+	return ""
+}
+
+func (x *PaymentReply_TransactionResult) GetServiceType() string {
+	if x != nil {
+		return x.ServiceType
+	}
+	return ""
+}
+
+func (x *PaymentReply_TransactionResult) GetCardTransactionType() string {
+	if x != nil {
+		return x.CardTransactionType
+	}
+	return ""
+}
+
+func (x *PaymentReply_TransactionResult) GetReceiptNo() string {
+	if x != nil && x.ReceiptNo != nil {
+		return *x.ReceiptNo
+	}
+	return ""
+}
+
+func (x *PaymentReply_TransactionResult) GetHaraikomiURL() string {
+	if x != nil && x.HaraikomiURL != nil {
+		return *x.HaraikomiURL
+	}
+	return ""
+}
+
+func (x *PaymentReply_TransactionResult) GetShunoKikanNo() string {
+	if x != nil && x.ShunoKikanNo != nil {
+		return *x.ShunoKikanNo
+	}
+	return ""
+}
+
+func (x *PaymentReply_TransactionResult) GetCustomerNo() string {
+	if x != nil && x.CustomerNo != nil {
+		return *x.CustomerNo
+	}
+	return ""
+}
+
+func (x *PaymentReply_TransactionResult) GetConfirmNo() string {
+	if x != nil && x.ConfirmNo != nil {
+		return *x.ConfirmNo
+	}
+	return ""
+}
+
+func (x *PaymentReply_TransactionResult) GetBillPattern() string {
+	if x != nil && x.BillPattern != nil {
+		return *x.BillPattern
+	}
+	return ""
+}
+
+func (x *PaymentReply_TransactionResult) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
+	}
+	return ""
+}
+
+func (x *PaymentReply_TransactionResult) GetResResponseContents() string {
+	if x != nil && x.ResResponseContents != nil {
+		return *x.ResResponseContents
+	}
+	return ""
+}
+
+func (x *PaymentReply_TransactionResult) GetLoginURL() string {
+	if x != nil && x.LoginURL != nil {
+		return *x.LoginURL
+	}
+	return ""
+}
+
+func (x *PaymentReply_TransactionResult) GetRedirectURL() string {
+	if x != nil && x.RedirectURL != nil {
+		return *x.RedirectURL
+	}
+	return ""
+}
+
+func (x *PaymentReply_TransactionResult) GetCarrierOrderID() string {
+	if x != nil && x.CarrierOrderID != nil {
+		return *x.CarrierOrderID
+	}
+	return ""
+}
+
+func (x *PaymentReply_TransactionResult) GetAppURL() string {
+	if x != nil && x.AppURL != nil {
+		return *x.AppURL
+	}
+	return ""
+}
+
+func (x *PaymentReply_TransactionResult) GetMailURL() string {
+	if x != nil && x.MailURL != nil {
+		return *x.MailURL
+	}
+	return ""
+}
+
+func (x *PaymentReply_TransactionResult) GetRefundableAmount() string {
+	if x != nil && x.RefundableAmount != nil {
+		return *x.RefundableAmount
+	}
+	return ""
+}
+
+type SearchReply_OrderInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderID            string                                   `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	AccountID          string                                   `protobuf:"bytes,2,opt,name=accountID,proto3" json:"accountID,omitempty"`
+	ServiceTypeCd      string                                   `protobuf:"bytes,3,opt,name=serviceTypeCd,proto3" json:"serviceTypeCd,omitempty"`
+	LastSuccessTxnType string                                   `protobuf:"bytes,4,opt,name=lastSuccessTxnType,proto3" json:"lastSuccessTxnType,omitempty"`
+	TransactionInfo    []*SearchReply_OrderInfo_TransactionInfo `protobuf:"bytes,5,rep,name=transactionInfo,proto3" json:"transactionInfo,omitempty"`
+	ProperOrderInfo    *SearchReply_OrderInfo_ProperOrderInfo   `protobuf:"bytes,6,opt,name=properOrderInfo,proto3,oneof" json:"properOrderInfo,omitempty"`
+}
+
+func (x *SearchReply_OrderInfo) Reset() {
+	*x = SearchReply_OrderInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_veritrans_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchReply_OrderInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchReply_OrderInfo) ProtoMessage() {}
+
+func (x *SearchReply_OrderInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_veritrans_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchReply_OrderInfo.ProtoReflect.Descriptor instead.
+func (*SearchReply_OrderInfo) Descriptor() ([]byte, []int) {
+	return file_veritrans_proto_rawDescGZIP(), []int{20, 0}
+}
+
+func (x *SearchReply_OrderInfo) GetOrderID() string {
+	if x != nil {
+		return x.OrderID
+	}
+	return ""
+}
+
+func (x *SearchReply_OrderInfo) GetAccountID() string {
+	if x != nil {
+		return x.AccountID
+	}
+	return ""
+}
+
+func (x *SearchReply_OrderInfo) GetServiceTypeCd() string {
+	if x != nil {
+		return x.ServiceTypeCd
+	}
+	return ""
+}
+
+func (x *SearchReply_OrderInfo) GetLastSuccessTxnType() string {
+	if x != nil {
+		return x.LastSuccessTxnType
+	}
+	return ""
+}
+
+func (x *SearchReply_OrderInfo) GetTransactionInfo() []*SearchReply_OrderInfo_TransactionInfo {
+	if x != nil {
+		return x.TransactionInfo
+	}
+	return nil
+}
+
+func (x *SearchReply_OrderInfo) GetProperOrderInfo() *SearchReply_OrderInfo_ProperOrderInfo {
+	if x != nil {
+		return x.ProperOrderInfo
+	}
+	return nil
+}
+
+type SearchReply_OrderInfo_TransactionInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxnID               string `protobuf:"bytes,1,opt,name=txnID,proto3" json:"txnID,omitempty"`
+	Command             string `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
+	Mstatus             string `protobuf:"bytes,3,opt,name=mstatus,proto3" json:"mstatus,omitempty"`
+	VResultCode         string `protobuf:"bytes,4,opt,name=vResultCode,proto3" json:"vResultCode,omitempty"`
+	Amount              string `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	TxnDateTime         string `protobuf:"bytes,6,opt,name=txnDateTime,proto3" json:"txnDateTime,omitempty"`
+	CardTransactionType string `protobuf:"bytes,7,opt,name=cardTransactionType,proto3" json:"cardTransactionType,omitempty"`
+	ReqWithCapture      string `protobuf:"bytes,8,opt,name=reqWithCapture,proto3" json:"reqWithCapture,omitempty"`
+	ReqJpoInformation   string `protobuf:"bytes,9,opt,name=reqJpoInformation,proto3" json:"reqJpoInformation,omitempty"`
+}
+
+func (x *SearchReply_OrderInfo_TransactionInfo) Reset() {
+	*x = SearchReply_OrderInfo_TransactionInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_veritrans_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchReply_OrderInfo_TransactionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchReply_OrderInfo_TransactionInfo) ProtoMessage() {}
+
+func (x *SearchReply_OrderInfo_TransactionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_veritrans_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchReply_OrderInfo_TransactionInfo.ProtoReflect.Descriptor instead.
+func (*SearchReply_OrderInfo_TransactionInfo) Descriptor() ([]byte, []int) {
+	return file_veritrans_proto_rawDescGZIP(), []int{20, 0, 0}
+}
+
+func (x *SearchReply_OrderInfo_TransactionInfo) GetTxnID() string {
+	if x != nil {
+		return x.TxnID
+	}
+	return ""
+}
+
+func (x *SearchReply_OrderInfo_TransactionInfo) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *SearchReply_OrderInfo_TransactionInfo) GetMstatus() string {
+	if x != nil {
+		return x.Mstatus
+	}
+	return ""
+}
+
+func (x *SearchReply_OrderInfo_TransactionInfo) GetVResultCode() string {
+	if x != nil {
+		return x.VResultCode
+	}
+	return ""
+}
+
+func (x *SearchReply_OrderInfo_TransactionInfo) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *SearchReply_OrderInfo_TransactionInfo) GetTxnDateTime() string {
+	if x != nil {
+		return x.TxnDateTime
+	}
+	return ""
+}
+
+func (x *SearchReply_OrderInfo_TransactionInfo) GetCardTransactionType() string {
+	if x != nil {
+		return x.CardTransactionType
+	}
+	return ""
+}
+
+func (x *SearchReply_OrderInfo_TransactionInfo) GetReqWithCapture() string {
+	if x != nil {
+		return x.ReqWithCapture
+	}
+	return ""
+}
+
+func (x *SearchReply_OrderInfo_TransactionInfo) GetReqJpoInformation() string {
+	if x != nil {
+		return x.ReqJpoInformation
+	}
+	return ""
+}
+
+type SearchReply_OrderInfo_ProperOrderInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShunoKikanNo     string `protobuf:"bytes,1,opt,name=shunoKikanNo,proto3" json:"shunoKikanNo,omitempty"`
+	CustomerNo       string `protobuf:"bytes,2,opt,name=customerNo,proto3" json:"customerNo,omitempty"`
+	ConfirmNo        string `protobuf:"bytes,3,opt,name=confirmNo,proto3" json:"confirmNo,omitempty"`
+	PayLimit         string `protobuf:"bytes,4,opt,name=payLimit,proto3" json:"payLimit,omitempty"`
+	ReceivedDatetime string `protobuf:"bytes,5,opt,name=receivedDatetime,proto3" json:"receivedDatetime,omitempty"`
+	CardAmount       string `protobuf:"bytes,6,opt,name=cardAmount,proto3" json:"cardAmount,omitempty"`
+	PointAmount      string `protobuf:"bytes,7,opt,name=pointAmount,proto3" json:"pointAmount,omitempty"`
+}
+
+func (x *SearchReply_OrderInfo_ProperOrderInfo) Reset() {
+	*x = SearchReply_OrderInfo_ProperOrderInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_veritrans_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchReply_OrderInfo_ProperOrderInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchReply_OrderInfo_ProperOrderInfo) ProtoMessage() {}
+
+func (x *SearchReply_OrderInfo_ProperOrderInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_veritrans_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchReply_OrderInfo_ProperOrderInfo.ProtoReflect.Descriptor instead.
+func (*SearchReply_OrderInfo_ProperOrderInfo) Descriptor() ([]byte, []int) {
+	return file_veritrans_proto_rawDescGZIP(), []int{20, 0, 1}
+}
+
+func (x *SearchReply_OrderInfo_ProperOrderInfo) GetShunoKikanNo() string {
+	if x != nil {
+		return x.ShunoKikanNo
+	}
+	return ""
+}
+
+func (x *SearchReply_OrderInfo_ProperOrderInfo) GetCustomerNo() string {
+	if x != nil {
+		return x.CustomerNo
+	}
+	return ""
+}
+
+func (x *SearchReply_OrderInfo_ProperOrderInfo) GetConfirmNo() string {
+	if x != nil {
+		return x.ConfirmNo
+	}
+	return ""
+}
+
+func (x *SearchReply_OrderInfo_ProperOrderInfo) GetPayLimit() string {
+	if x != nil {
+		return x.PayLimit
+	}
+	return ""
+}
 
-func (x *PaymentRequest_PayNowIDParam_AccountParam) ProtoReflect() protoreflect.Message {
-	mi := &file_veritrans_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *SearchReply_OrderInfo_ProperOrderInfo) GetReceivedDatetime() string {
+	if x != nil {
+		return x.ReceivedDatetime
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use PaymentRequest_PayNowIDParam_AccountParam.ProtoReflect.Descriptor instead.
-func (*PaymentRequest_PayNowIDParam_AccountParam) Descriptor() ([]byte, []int) {
-	return file_veritrans_proto_rawDescGZIP(), []int{4, 0, 0}
+func (x *SearchReply_OrderInfo_ProperOrderInfo) GetCardAmount() string {
+	if x != nil {
+		return x.CardAmount
+	}
+	return ""
 }
 
-func (x *PaymentRequest_PayNowIDParam_AccountParam) GetAccountID() string {
+func (x *SearchReply_OrderInfo_ProperOrderInfo) GetPointAmount() string {
 	if x != nil {
-		return x.AccountID
+		return x.PointAmount
 	}
 	return ""
 }
@@ -700,86 +2955,560 @@ var file_veritrans_proto_rawDesc = []byte{
 	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
 	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
 	0x12, 0x10, 0x0a, 0x03, 0x65, 0x72, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65,
-	0x72, 0x72, 0x22, 0xcf, 0x02, 0x0a, 0x0e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x72, 0x72, 0x22, 0xc8, 0x04, 0x0a, 0x0e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
 	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
 	0x74, 0x49, 0x44, 0x12, 0x3c, 0x0a, 0x09, 0x63, 0x61, 0x72, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x50, 0x61, 0x72, 0x61,
 	0x6d, 0x48, 0x00, 0x52, 0x09, 0x63, 0x61, 0x72, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x88, 0x01,
-	0x01, 0x1a, 0xd2, 0x01, 0x0a, 0x09, 0x43, 0x61, 0x72, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12,
-	0x23, 0x0a, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x45, 0x78, 0x70, 0x69,
-	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0a, 0x63, 0x61, 0x72, 0x64,
-	0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x66,
-	0x61, 0x75, 0x6c, 0x74, 0x43, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02,
-	0x52, 0x0b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x61, 0x72, 0x64, 0x88, 0x01, 0x01,
-	0x12, 0x1b, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x03, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a,
-	0x0b, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x0d, 0x0a, 0x0b,
-	0x5f, 0x63, 0x61, 0x72, 0x64, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
-	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x61, 0x72, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f,
-	0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x22, 0xca, 0x02, 0x0a, 0x0c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x33, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x72,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x72, 0x72, 0x1a, 0xf2, 0x01, 0x0a,
-	0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x3e, 0x0a, 0x08, 0x63, 0x61,
-	0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x08, 0x63, 0x61, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x84, 0x01, 0x0a, 0x08, 0x43,
-	0x61, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12,
-	0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x12,
-	0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
-	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x61, 0x72, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x61, 0x72,
-	0x64, 0x22, 0xb0, 0x03, 0x0a, 0x0e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16,
-	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x15, 0x0a, 0x03, 0x6a, 0x70, 0x6f, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x6a, 0x70, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a,
+	0x01, 0x12, 0x54, 0x0a, 0x11, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x73, 0x69,
+	0x63, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x73, 0x69, 0x63, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x48,
+	0x01, 0x52, 0x11, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x73, 0x69, 0x63, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x88, 0x01, 0x01, 0x1a, 0xd2, 0x01, 0x0a, 0x09, 0x43, 0x61, 0x72, 0x64,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x23, 0x0a, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x61, 0x72,
+	0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x63, 0x61,
+	0x72, 0x64, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
+	0x52, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x25, 0x0a, 0x0b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x61, 0x72, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x43,
+	0x61, 0x72, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44,
+	0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x61, 0x72,
+	0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x1a, 0x8a, 0x01, 0x0a,
+	0x11, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x73, 0x69, 0x63, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x12, 0x23, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x44, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x0f, 0x66, 0x6f, 0x72, 0x63, 0x65,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x01, 0x52, 0x0f, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44,
+	0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x44, 0x61, 0x74, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x61,
+	0x72, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x42, 0x61, 0x73, 0x69, 0x63, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x22, 0xba, 0x04,
+	0x0a, 0x0c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x33,
+	0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x72, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x65, 0x72, 0x72, 0x1a, 0xe2, 0x03, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x49, 0x44, 0x12, 0x3e, 0x0a, 0x08, 0x63, 0x61, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x2e, 0x43, 0x61, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x63, 0x61, 0x72, 0x64, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x5b, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61,
+	0x73, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x42, 0x61, 0x73, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x10, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x73, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x88, 0x01, 0x01,
+	0x1a, 0x84, 0x01, 0x0a, 0x08, 0x43, 0x61, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63,
+	0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x45, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x43, 0x61, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x43, 0x61, 0x72, 0x64, 0x1a, 0x7c, 0x0a, 0x10, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x42, 0x61, 0x73, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x66,
+	0x6f, 0x72, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x44, 0x61, 0x74, 0x65, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x42, 0x61, 0x73, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xc8, 0x02, 0x0a, 0x16, 0x52,
+	0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x21, 0x0a,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x1d, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x01, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x25, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0b, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x43, 0x68, 0x61,
+	0x72, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0d, 0x6f, 0x6e, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52,
+	0x0d, 0x6f, 0x6e, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x1b, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0c,
+	0x0a, 0x0a, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x42, 0x0a, 0x0a, 0x08,
+	0x5f, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x66, 0x69, 0x6e,
+	0x61, 0x6c, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6f, 0x6e, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xdf, 0x02, 0x0a, 0x14, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72,
+	0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1c,
+	0x0a, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x51, 0x0a, 0x10,
+	0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69,
+	0x6e, 0x67, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65,
+	0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x10, 0x72,
+	0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x12,
+	0x10, 0x0a, 0x03, 0x65, 0x72, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x72,
+	0x72, 0x1a, 0xc3, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x43,
+	0x68, 0x61, 0x72, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x61, 0x6c,
+	0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69,
+	0x6e, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6f, 0x6e, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6f, 0x6e, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xe1, 0x07, 0x0a, 0x0e, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x03,
+	0x6a, 0x70, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x48, 0x01, 0x52,
+	0x03, 0x6a, 0x70, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x43,
+	0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0b,
+	0x77, 0x69, 0x74, 0x68, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x48,
+	0x0a, 0x0d, 0x70, 0x61, 0x79, 0x4e, 0x6f, 0x77, 0x49, 0x44, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x61, 0x79, 0x4e, 0x6f, 0x77, 0x49, 0x44, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x48, 0x03, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x4e, 0x6f, 0x77, 0x49, 0x44,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x08, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05,
+	0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x26, 0x0a, 0x03, 0x63, 0x76, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x43, 0x56, 0x53, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x48, 0x00, 0x52, 0x03, 0x63, 0x76, 0x73, 0x12, 0x29, 0x0a, 0x04, 0x62, 0x61, 0x6e, 0x6b,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x42, 0x61, 0x6e, 0x6b, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x04, 0x62,
+	0x61, 0x6e, 0x6b, 0x12, 0x26, 0x0a, 0x03, 0x6d, 0x70, 0x69, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x4d, 0x50, 0x49, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x03, 0x6d, 0x70, 0x69, 0x12, 0x2f, 0x0a, 0x06, 0x70,
+	0x61, 0x79, 0x70, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x50, 0x61,
+	0x79, 0x70, 0x61, 0x6c, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x06, 0x70, 0x61, 0x79, 0x70, 0x61, 0x6c, 0x12, 0x32, 0x0a, 0x07,
+	0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e,
+	0x12, 0x32, 0x0a, 0x07, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x07, 0x63, 0x61, 0x72,
+	0x72, 0x69, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x02, 0x65, 0x6d, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x45, 0x4d, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x02, 0x65, 0x6d, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x61, 0x69,
+	0x73, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x53, 0x61, 0x69, 0x73,
+	0x6f, 0x6e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x00, 0x52, 0x06, 0x73, 0x61, 0x69, 0x73, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0d, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x48, 0x06, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x88, 0x01, 0x01, 0x1a, 0xb9, 0x01, 0x0a, 0x0d, 0x50, 0x61, 0x79, 0x4e, 0x6f, 0x77,
+	0x49, 0x44, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x53, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x50,
+	0x61, 0x79, 0x4e, 0x6f, 0x77, 0x49, 0x44, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x48, 0x00, 0x52, 0x0c, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x1a, 0x2c, 0x0a, 0x0c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44,
+	0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x42, 0x0f, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6a, 0x70, 0x6f, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x77,
+	0x69, 0x74, 0x68, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x70,
+	0x61, 0x79, 0x4e, 0x6f, 0x77, 0x49, 0x44, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x42, 0x0b, 0x0a, 0x09,
+	0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x69, 0x0a, 0x0d, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x2f, 0x0a, 0x10, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x10, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01,
+	0x01, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
+	0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x92, 0x02, 0x0a, 0x13, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x21, 0x0a, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x77, 0x69, 0x74,
+	0x68, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
+	0x52, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x39, 0x0a, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x02, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x77, 0x69,
+	0x74, 0x68, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd7, 0x02, 0x0a, 0x12,
+	0x52, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19,
+	0x0a, 0x03, 0x6a, 0x70, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x48,
+	0x00, 0x52, 0x03, 0x6a, 0x70, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x77, 0x69, 0x74,
+	0x68, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
+	0x52, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x2b, 0x0a, 0x0e, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x0e, 0x63, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a,
+	0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x03, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6a, 0x70, 0x6f,
+	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65,
+	0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x61, 0x6c, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9a, 0x02, 0x0a, 0x11, 0x43, 0x56, 0x53, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a,
+	0x11, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e,
+	0x61, 0x6d, 0x65, 0x31, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65,
+	0x31, 0x12, 0x19, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x32, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x32, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x65, 0x6c, 0x4e, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x65, 0x6c,
+	0x4e, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x27,
+	0x0a, 0x0c, 0x70, 0x61, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x48, 0x68, 0x6d, 0x6d, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0c, 0x70, 0x61, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x48, 0x68, 0x6d, 0x6d, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x32, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x70, 0x61, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x48, 0x68,
+	0x6d, 0x6d, 0x22, 0xf5, 0x02, 0x0a, 0x12, 0x42, 0x61, 0x6e, 0x6b, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d,
+	0x65, 0x31, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x31, 0x12,
+	0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x32, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6e, 0x61, 0x6d, 0x65, 0x32, 0x12, 0x14, 0x0a, 0x05, 0x6b, 0x61, 0x6e, 0x61, 0x31, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x61, 0x6e, 0x61, 0x31, 0x12, 0x14, 0x0a, 0x05, 0x6b,
+	0x61, 0x6e, 0x61, 0x32, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x61, 0x6e, 0x61,
+	0x32, 0x12, 0x19, 0x0a, 0x05, 0x74, 0x65, 0x6c, 0x4e, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x05, 0x74, 0x65, 0x6c, 0x4e, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x61, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x61, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x08, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0c, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x73, 0x4b, 0x61, 0x6e, 0x61, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x02, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x4b, 0x61, 0x6e, 0x61, 0x88,
+	0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x74, 0x65, 0x6c, 0x4e, 0x6f, 0x42, 0x0b, 0x0a, 0x09,
+	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x4b, 0x61, 0x6e, 0x61, 0x22, 0xf8, 0x03, 0x0a, 0x11, 0x4d,
+	0x50, 0x49, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x19, 0x0a, 0x03, 0x6a, 0x70, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x02, 0x18, 0x01, 0x48, 0x00, 0x52, 0x03, 0x6a, 0x70, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a,
 	0x0b, 0x77, 0x69, 0x74, 0x68, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01,
 	0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72,
-	0x65, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x0d, 0x70, 0x61, 0x79, 0x4e, 0x6f, 0x77, 0x49, 0x44,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x43, 0x0a, 0x0d, 0x70, 0x61, 0x79, 0x4e, 0x6f, 0x77, 0x49, 0x44,
 	0x50, 0x61, 0x72, 0x61, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x50, 0x61,
 	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x61, 0x79,
-	0x4e, 0x6f, 0x77, 0x49, 0x44, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x48, 0x02, 0x52, 0x0d, 0x70, 0x61,
-	0x79, 0x4e, 0x6f, 0x77, 0x49, 0x44, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x88, 0x01, 0x01, 0x1a, 0xb9,
-	0x01, 0x0a, 0x0d, 0x50, 0x61, 0x79, 0x4e, 0x6f, 0x77, 0x49, 0x44, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x12, 0x53, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x61, 0x79, 0x4e, 0x6f, 0x77, 0x49, 0x44,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x48, 0x00, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x2c, 0x0a, 0x0c, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6a,
+	0x4e, 0x6f, 0x77, 0x49, 0x44, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x4e,
+	0x6f, 0x77, 0x49, 0x44, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x2c, 0x0a, 0x11, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x52, 0x49, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x52, 0x49, 0x12,
+	0x29, 0x0a, 0x0d, 0x68, 0x74, 0x74, 0x70, 0x55, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0d, 0x68, 0x74, 0x74, 0x70, 0x55, 0x73,
+	0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x68, 0x74,
+	0x74, 0x70, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03,
+	0x52, 0x0a, 0x68, 0x74, 0x74, 0x70, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x39, 0x0a, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x04, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6a,
 	0x70, 0x6f, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x43, 0x61, 0x70, 0x74, 0x75,
-	0x72, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x70, 0x61, 0x79, 0x4e, 0x6f, 0x77, 0x49, 0x44, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x22, 0x20, 0x0a, 0x0c, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x72, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x65, 0x72, 0x72, 0x32, 0xe9, 0x03, 0x0a, 0x09, 0x56, 0x65, 0x72, 0x69, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x12, 0x31, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4d, 0x44, 0x4b, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x12, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x44, 0x4b, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x0f, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x0d, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x0f, 0x2e, 0x41, 0x63,
+	0x72, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x68, 0x74, 0x74, 0x70, 0x55, 0x73, 0x65, 0x72, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x68, 0x74, 0x74, 0x70, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xbb, 0x01, 0x0a, 0x14, 0x50, 0x61, 0x79, 0x70, 0x61, 0x6c,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x25, 0x0a, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x43, 0x61, 0x70,
+	0x74, 0x75, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x55, 0x52, 0x4c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x55, 0x52, 0x4c, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x55,
+	0x52, 0x4c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x55, 0x52, 0x4c, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x43, 0x61, 0x70, 0x74,
+	0x75, 0x72, 0x65, 0x22, 0xf2, 0x01, 0x0a, 0x15, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1f, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x88, 0x01, 0x01,
+	0x12, 0x25, 0x0a, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x43, 0x61, 0x70,
+	0x74, 0x75, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x64, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x64, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x55, 0x52, 0x4c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x55, 0x52, 0x4c, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x77, 0x69, 0x74,
+	0x68, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x22, 0xc8, 0x02, 0x0a, 0x15, 0x43, 0x61, 0x72,
+	0x72, 0x69, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x43, 0x61, 0x70, 0x74,
+	0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x77, 0x69, 0x74,
+	0x68, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x11, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x65, 0x72,
+	0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x4b, 0x69, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x69, 0x74, 0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x69, 0x74, 0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x55, 0x52, 0x4c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x55, 0x52, 0x4c, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x55, 0x52, 0x4c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x55, 0x52, 0x4c, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x55, 0x52, 0x4c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x55, 0x52, 0x4c, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x43, 0x61, 0x70, 0x74,
+	0x75, 0x72, 0x65, 0x22, 0xb3, 0x02, 0x0a, 0x10, 0x45, 0x4d, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x08, 0x6d, 0x61, 0x69, 0x6c,
+	0x41, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x61,
+	0x69, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x55, 0x52, 0x4c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
+	0x0a, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x55, 0x52, 0x4c, 0x88, 0x01, 0x01, 0x12, 0x21,
+	0x0a, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x55, 0x52, 0x4c, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x02, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x55, 0x52, 0x4c, 0x88, 0x01,
+	0x01, 0x12, 0x1f, 0x0a, 0x08, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x55, 0x52, 0x4c, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x08, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x55, 0x52, 0x4c, 0x88,
+	0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x61, 0x69, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x55, 0x52, 0x4c, 0x42, 0x0c,
+	0x0a, 0x0a, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x55, 0x52, 0x4c, 0x42, 0x0b, 0x0a, 0x09,
+	0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x55, 0x52, 0x4c, 0x22, 0xf6, 0x02, 0x0a, 0x14, 0x53, 0x61,
+	0x69, 0x73, 0x6f, 0x6e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x03, 0x6a, 0x70, 0x6f, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x48, 0x00, 0x52, 0x03, 0x6a, 0x70, 0x6f, 0x88, 0x01,
+	0x01, 0x12, 0x25, 0x0a, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x43, 0x61,
+	0x70, 0x74, 0x75, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x43, 0x0a, 0x0d, 0x70, 0x61, 0x79, 0x4e,
+	0x6f, 0x77, 0x49, 0x44, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x50, 0x61, 0x79, 0x4e, 0x6f, 0x77, 0x49, 0x44, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x0d,
+	0x70, 0x61, 0x79, 0x4e, 0x6f, 0x77, 0x49, 0x44, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x39, 0x0a,
+	0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x02, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6a, 0x70, 0x6f,
+	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65,
+	0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0xd6, 0x08, 0x0a, 0x0c, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x72, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x65, 0x72, 0x72, 0x12, 0x37, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x1a, 0xfa,
+	0x07, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x76, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x43,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x76, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x78,
+	0x6e, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x78, 0x6e, 0x49, 0x44,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x78, 0x6e, 0x44,
+	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74,
+	0x78, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x30, 0x0a, 0x13,
+	0x63, 0x61, 0x72, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x63, 0x61, 0x72, 0x64, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21,
+	0x0a, 0x09, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x4e, 0x6f, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x09, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x4e, 0x6f, 0x88, 0x01,
+	0x01, 0x12, 0x27, 0x0a, 0x0c, 0x68, 0x61, 0x72, 0x61, 0x69, 0x6b, 0x6f, 0x6d, 0x69, 0x55, 0x52,
+	0x4c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0c, 0x68, 0x61, 0x72, 0x61, 0x69,
+	0x6b, 0x6f, 0x6d, 0x69, 0x55, 0x52, 0x4c, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0c, 0x73, 0x68,
+	0x75, 0x6e, 0x6f, 0x4b, 0x69, 0x6b, 0x61, 0x6e, 0x4e, 0x6f, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x02, 0x52, 0x0c, 0x73, 0x68, 0x75, 0x6e, 0x6f, 0x4b, 0x69, 0x6b, 0x61, 0x6e, 0x4e, 0x6f,
+	0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4e,
+	0x6f, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x65, 0x72, 0x4e, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x4e, 0x6f, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x09, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x4e, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x62,
+	0x69, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x05, 0x52, 0x0b, 0x62, 0x69, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x88,
+	0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x06, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x13, 0x72, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x48, 0x07, 0x52, 0x13, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x88, 0x01, 0x01,
+	0x12, 0x1f, 0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x55, 0x52, 0x4c, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x08, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x55, 0x52, 0x4c, 0x88, 0x01,
+	0x01, 0x12, 0x25, 0x0a, 0x0b, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x52, 0x4c,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x48, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x55, 0x52, 0x4c, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0e, 0x63, 0x61, 0x72, 0x72,
+	0x69, 0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x0a, 0x52, 0x0e, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x55, 0x52, 0x4c, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x09, 0x48, 0x0b, 0x52, 0x06, 0x61, 0x70, 0x70, 0x55, 0x52, 0x4c, 0x88,
+	0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x61, 0x69, 0x6c, 0x55, 0x52, 0x4c, 0x18, 0x15, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x0c, 0x52, 0x07, 0x6d, 0x61, 0x69, 0x6c, 0x55, 0x52, 0x4c, 0x88, 0x01,
+	0x01, 0x12, 0x2f, 0x0a, 0x10, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x48, 0x0d, 0x52, 0x10, 0x72,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x88,
+	0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x4e, 0x6f,
+	0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x68, 0x61, 0x72, 0x61, 0x69, 0x6b, 0x6f, 0x6d, 0x69, 0x55, 0x52,
+	0x4c, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x73, 0x68, 0x75, 0x6e, 0x6f, 0x4b, 0x69, 0x6b, 0x61, 0x6e,
+	0x4e, 0x6f, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4e,
+	0x6f, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x4e, 0x6f, 0x42,
+	0x0e, 0x0a, 0x0c, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x42,
+	0x06, 0x0a, 0x04, 0x5f, 0x75, 0x72, 0x6c, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x72, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x42,
+	0x0b, 0x0a, 0x09, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x55, 0x52, 0x4c, 0x42, 0x0e, 0x0a, 0x0c,
+	0x5f, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x52, 0x4c, 0x42, 0x11, 0x0a, 0x0f,
+	0x5f, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x61, 0x70, 0x70, 0x55, 0x52, 0x4c, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d,
+	0x61, 0x69, 0x6c, 0x55, 0x52, 0x4c, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x72, 0x65, 0x66, 0x75, 0x6e,
+	0x64, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xfe, 0x01, 0x0a, 0x0d,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12,
+	0x17, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x13, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x02, 0x74, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a,
+	0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x12, 0x21, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x65, 0x72, 0x46, 0x6c, 0x61, 0x67, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x09, 0x6e, 0x65, 0x77, 0x65, 0x72, 0x46, 0x6c, 0x61,
+	0x67, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44,
+	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x42, 0x07,
+	0x0a, 0x05, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x74, 0x6f, 0x42, 0x0c,
+	0x0a, 0x0a, 0x5f, 0x6e, 0x65, 0x77, 0x65, 0x72, 0x46, 0x6c, 0x61, 0x67, 0x22, 0xf0, 0x07, 0x0a,
+	0x0b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x34, 0x0a, 0x09,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x72, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x65, 0x72, 0x72, 0x1a, 0x98, 0x07, 0x0a, 0x09, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x43, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x43, 0x64,
+	0x12, 0x2e, 0x0a, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54,
+	0x78, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x6c, 0x61,
+	0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x78, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x50, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x55, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x88, 0x01, 0x01, 0x1a, 0xbf, 0x02, 0x0a, 0x0f, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x78, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x78,
+	0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x76, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x76, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x78, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x78, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x13, 0x63, 0x61, 0x72, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x13, 0x63, 0x61, 0x72, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x57, 0x69, 0x74, 0x68,
+	0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72,
+	0x65, 0x71, 0x57, 0x69, 0x74, 0x68, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2c, 0x0a,
+	0x11, 0x72, 0x65, 0x71, 0x4a, 0x70, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x71, 0x4a, 0x70, 0x6f,
+	0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0xfd, 0x01, 0x0a, 0x0f,
+	0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x22, 0x0a, 0x0c, 0x73, 0x68, 0x75, 0x6e, 0x6f, 0x4b, 0x69, 0x6b, 0x61, 0x6e, 0x4e, 0x6f, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x68, 0x75, 0x6e, 0x6f, 0x4b, 0x69, 0x6b, 0x61,
+	0x6e, 0x4e, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4e,
+	0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
+	0x72, 0x4e, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x4e, 0x6f,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x4e,
+	0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2a, 0x0a,
+	0x10, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x64, 0x44, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x72,
+	0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x61, 0x72, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f,
+	0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x32,
+	0xd8, 0x09, 0x0a, 0x09, 0x56, 0x65, 0x72, 0x69, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x12, 0x31, 0x0a,
+	0x0b, 0x47, 0x65, 0x74, 0x4d, 0x44, 0x4b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x13, 0x2e, 0x47,
+	0x65, 0x74, 0x4d, 0x44, 0x4b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0b, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
+	0x12, 0x31, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x0f, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x0f, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x0f, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x0f, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x0e, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x0f, 0x2e, 0x41, 0x63,
 	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x41,
 	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x2e, 0x0a,
 	0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x12, 0x0f, 0x2e, 0x41, 0x63,
@@ -793,20 +3522,57 @@ var file_veritrans_proto_rawDesc = []byte{
 	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x2b, 0x0a,
 	0x07, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x12, 0x0f, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
 	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x09, 0x41, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x12, 0x0f, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x07, 0x43, 0x61, 0x70,
-	0x74, 0x75, 0x72, 0x65, 0x12, 0x0f, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x06, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x15, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61,
+	0x72, 0x67, 0x65, 0x12, 0x17, 0x2e, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x43,
+	0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x52,
+	0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x12, 0x17,
+	0x2e, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72,
+	0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
+	0x12, 0x49, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72,
+	0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x12, 0x17, 0x2e, 0x52, 0x65, 0x63, 0x75,
+	0x72, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x68,
+	0x61, 0x72, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x72, 0x67,
+	0x65, 0x12, 0x17, 0x2e, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61,
+	0x72, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x52, 0x65, 0x63,
+	0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x09, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65,
 	0x12, 0x0f, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x1a, 0x0d, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x00, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x64, 0x61, 0x76, 0x69, 0x64, 0x31, 0x39, 0x39, 0x32, 0x31, 0x32, 0x31, 0x2f, 0x76, 0x65,
-	0x72, 0x74, 0x69, 0x72, 0x61, 0x6e, 0x73, 0x2d, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x22, 0x00, 0x12, 0x2b, 0x0a, 0x07, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x12, 0x0f, 0x2e,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d,
+	0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
+	0x2a, 0x0a, 0x06, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0x0f, 0x2e, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x06, 0x52,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x12, 0x0f, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x0c, 0x50, 0x61, 0x79, 0x57, 0x69,
+	0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x33,
+	0x0a, 0x0b, 0x52, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x12, 0x13, 0x2e,
+	0x52, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x0e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x61,
+	0x70, 0x74, 0x75, 0x72, 0x65, 0x12, 0x0f, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x0d, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x61, 0x6c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0x0f, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x0c, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x0e, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x76, 0x69, 0x64, 0x31, 0x39,
+	0x39, 0x32, 0x31, 0x32, 0x31, 0x2f, 0x76, 0x65, 0x72, 0x74, 0x69, 0x72, 0x61, 0x6e, 0x73, 0x2d,
+	0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -821,51 +3587,121 @@ func file_veritrans_proto_rawDescGZIP() []byte {
 	return file_veritrans_proto_rawDescData
 }
 
-var file_veritrans_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_veritrans_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_veritrans_proto_goTypes = []interface{}{
 	(*GetMDKTokenRequest)(nil),                        // 0: GetMDKTokenRequest
 	(*TokenReply)(nil),                                // 1: TokenReply
 	(*AccountRequest)(nil),                            // 2: AccountRequest
 	(*AccountReply)(nil),                              // 3: AccountReply
-	(*PaymentRequest)(nil),                            // 4: PaymentRequest
-	(*PaymentReply)(nil),                              // 5: PaymentReply
-	(*AccountRequest_CardParam)(nil),                  // 6: AccountRequest.CardParam
-	(*AccountReply_AccountInfo)(nil),                  // 7: AccountReply.AccountInfo
-	(*AccountReply_AccountInfo_CardInfo)(nil),         // 8: AccountReply.AccountInfo.CardInfo
-	(*PaymentRequest_PayNowIDParam)(nil),              // 9: PaymentRequest.PayNowIDParam
-	(*PaymentRequest_PayNowIDParam_AccountParam)(nil), // 10: PaymentRequest.PayNowIDParam.AccountParam
+	(*RecurringChargeRequest)(nil),                    // 4: RecurringChargeRequest
+	(*RecurringChargeReply)(nil),                      // 5: RecurringChargeReply
+	(*PaymentRequest)(nil),                            // 6: PaymentRequest
+	(*PaymentOption)(nil),                             // 7: PaymentOption
+	(*TokenPaymentRequest)(nil),                       // 8: TokenPaymentRequest
+	(*ReAuthorizeRequest)(nil),                        // 9: ReAuthorizeRequest
+	(*CVSPaymentRequest)(nil),                         // 10: CVSPaymentRequest
+	(*BankPaymentRequest)(nil),                        // 11: BankPaymentRequest
+	(*MPIPaymentRequest)(nil),                         // 12: MPIPaymentRequest
+	(*PaypalPaymentRequest)(nil),                      // 13: PaypalPaymentRequest
+	(*ForeignPaymentRequest)(nil),                     // 14: ForeignPaymentRequest
+	(*CarrierPaymentRequest)(nil),                     // 15: CarrierPaymentRequest
+	(*EMPaymentRequest)(nil),                          // 16: EMPaymentRequest
+	(*SaisonPaymentRequest)(nil),                      // 17: SaisonPaymentRequest
+	(*PaymentReply)(nil),                              // 18: PaymentReply
+	(*SearchRequest)(nil),                             // 19: SearchRequest
+	(*SearchReply)(nil),                               // 20: SearchReply
+	(*AccountRequest_CardParam)(nil),                  // 21: AccountRequest.CardParam
+	(*AccountRequest_AccountBasicParam)(nil),          // 22: AccountRequest.AccountBasicParam
+	(*AccountReply_AccountInfo)(nil),                  // 23: AccountReply.AccountInfo
+	(*AccountReply_AccountInfo_CardInfo)(nil),         // 24: AccountReply.AccountInfo.CardInfo
+	(*AccountReply_AccountInfo_AccountBasicInfo)(nil), // 25: AccountReply.AccountInfo.AccountBasicInfo
+	(*RecurringChargeReply_RecurringCharge)(nil),      // 26: RecurringChargeReply.RecurringCharge
+	(*PaymentRequest_PayNowIDParam)(nil),              // 27: PaymentRequest.PayNowIDParam
+	(*PaymentRequest_PayNowIDParam_AccountParam)(nil), // 28: PaymentRequest.PayNowIDParam.AccountParam
+	(*PaymentReply_TransactionResult)(nil),            // 29: PaymentReply.TransactionResult
+	(*SearchReply_OrderInfo)(nil),                     // 30: SearchReply.OrderInfo
+	(*SearchReply_OrderInfo_TransactionInfo)(nil),     // 31: SearchReply.OrderInfo.TransactionInfo
+	(*SearchReply_OrderInfo_ProperOrderInfo)(nil),     // 32: SearchReply.OrderInfo.ProperOrderInfo
 }
 var file_veritrans_proto_depIdxs = []int32{
-	6,  // 0: AccountRequest.cardParam:type_name -> AccountRequest.CardParam
-	7,  // 1: AccountReply.account:type_name -> AccountReply.AccountInfo
-	9,  // 2: PaymentRequest.payNowIDParam:type_name -> PaymentRequest.PayNowIDParam
-	8,  // 3: AccountReply.AccountInfo.cardInfo:type_name -> AccountReply.AccountInfo.CardInfo
-	10, // 4: PaymentRequest.PayNowIDParam.accountParam:type_name -> PaymentRequest.PayNowIDParam.AccountParam
-	0,  // 5: Veritrans.GetMDKToken:input_type -> GetMDKTokenRequest
-	2,  // 6: Veritrans.CreateAccount:input_type -> AccountRequest
-	2,  // 7: Veritrans.UpdateAccount:input_type -> AccountRequest
-	2,  // 8: Veritrans.CreateCard:input_type -> AccountRequest
-	2,  // 9: Veritrans.UpdateCard:input_type -> AccountRequest
-	2,  // 10: Veritrans.DeleteCard:input_type -> AccountRequest
-	2,  // 11: Veritrans.GetCard:input_type -> AccountRequest
-	4,  // 12: Veritrans.Authorize:input_type -> PaymentRequest
-	4,  // 13: Veritrans.Capture:input_type -> PaymentRequest
-	4,  // 14: Veritrans.Cancel:input_type -> PaymentRequest
-	1,  // 15: Veritrans.GetMDKToken:output_type -> TokenReply
-	3,  // 16: Veritrans.CreateAccount:output_type -> AccountReply
-	3,  // 17: Veritrans.UpdateAccount:output_type -> AccountReply
-	3,  // 18: Veritrans.CreateCard:output_type -> AccountReply
-	3,  // 19: Veritrans.UpdateCard:output_type -> AccountReply
-	3,  // 20: Veritrans.DeleteCard:output_type -> AccountReply
-	3,  // 21: Veritrans.GetCard:output_type -> AccountReply
-	5,  // 22: Veritrans.Authorize:output_type -> PaymentReply
-	5,  // 23: Veritrans.Capture:output_type -> PaymentReply
-	5,  // 24: Veritrans.Cancel:output_type -> PaymentReply
-	15, // [15:25] is the sub-list for method output_type
-	5,  // [5:15] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	21, // 0: AccountRequest.cardParam:type_name -> AccountRequest.CardParam
+	22, // 1: AccountRequest.accountBasicParam:type_name -> AccountRequest.AccountBasicParam
+	23, // 2: AccountReply.account:type_name -> AccountReply.AccountInfo
+	26, // 3: RecurringChargeReply.recurringCharges:type_name -> RecurringChargeReply.RecurringCharge
+	27, // 4: PaymentRequest.payNowIDParam:type_name -> PaymentRequest.PayNowIDParam
+	10, // 5: PaymentRequest.cvs:type_name -> CVSPaymentRequest
+	11, // 6: PaymentRequest.bank:type_name -> BankPaymentRequest
+	12, // 7: PaymentRequest.mpi:type_name -> MPIPaymentRequest
+	13, // 8: PaymentRequest.paypal:type_name -> PaypalPaymentRequest
+	14, // 9: PaymentRequest.foreign:type_name -> ForeignPaymentRequest
+	15, // 10: PaymentRequest.carrier:type_name -> CarrierPaymentRequest
+	16, // 11: PaymentRequest.em:type_name -> EMPaymentRequest
+	17, // 12: PaymentRequest.saison:type_name -> SaisonPaymentRequest
+	7,  // 13: PaymentRequest.paymentOption:type_name -> PaymentOption
+	7,  // 14: TokenPaymentRequest.paymentOption:type_name -> PaymentOption
+	7,  // 15: ReAuthorizeRequest.paymentOption:type_name -> PaymentOption
+	27, // 16: MPIPaymentRequest.payNowIDParam:type_name -> PaymentRequest.PayNowIDParam
+	7,  // 17: MPIPaymentRequest.paymentOption:type_name -> PaymentOption
+	27, // 18: SaisonPaymentRequest.payNowIDParam:type_name -> PaymentRequest.PayNowIDParam
+	7,  // 19: SaisonPaymentRequest.paymentOption:type_name -> PaymentOption
+	29, // 20: PaymentReply.result:type_name -> PaymentReply.TransactionResult
+	30, // 21: SearchReply.orderInfo:type_name -> SearchReply.OrderInfo
+	24, // 22: AccountReply.AccountInfo.cardInfo:type_name -> AccountReply.AccountInfo.CardInfo
+	25, // 23: AccountReply.AccountInfo.accountBasicInfo:type_name -> AccountReply.AccountInfo.AccountBasicInfo
+	28, // 24: PaymentRequest.PayNowIDParam.accountParam:type_name -> PaymentRequest.PayNowIDParam.AccountParam
+	31, // 25: SearchReply.OrderInfo.transactionInfo:type_name -> SearchReply.OrderInfo.TransactionInfo
+	32, // 26: SearchReply.OrderInfo.properOrderInfo:type_name -> SearchReply.OrderInfo.ProperOrderInfo
+	0,  // 27: Veritrans.GetMDKToken:input_type -> GetMDKTokenRequest
+	2,  // 28: Veritrans.CreateAccount:input_type -> AccountRequest
+	2,  // 29: Veritrans.UpdateAccount:input_type -> AccountRequest
+	2,  // 30: Veritrans.GetAccount:input_type -> AccountRequest
+	2,  // 31: Veritrans.DeleteAccount:input_type -> AccountRequest
+	2,  // 32: Veritrans.RestoreAccount:input_type -> AccountRequest
+	2,  // 33: Veritrans.CreateCard:input_type -> AccountRequest
+	2,  // 34: Veritrans.UpdateCard:input_type -> AccountRequest
+	2,  // 35: Veritrans.DeleteCard:input_type -> AccountRequest
+	2,  // 36: Veritrans.GetCard:input_type -> AccountRequest
+	4,  // 37: Veritrans.CreateRecurringCharge:input_type -> RecurringChargeRequest
+	4,  // 38: Veritrans.UpdateRecurringCharge:input_type -> RecurringChargeRequest
+	4,  // 39: Veritrans.DeleteRecurringCharge:input_type -> RecurringChargeRequest
+	4,  // 40: Veritrans.GetRecurringCharge:input_type -> RecurringChargeRequest
+	6,  // 41: Veritrans.Authorize:input_type -> PaymentRequest
+	6,  // 42: Veritrans.Capture:input_type -> PaymentRequest
+	6,  // 43: Veritrans.Cancel:input_type -> PaymentRequest
+	6,  // 44: Veritrans.Refund:input_type -> PaymentRequest
+	8,  // 45: Veritrans.PayWithToken:input_type -> TokenPaymentRequest
+	9,  // 46: Veritrans.ReAuthorize:input_type -> ReAuthorizeRequest
+	6,  // 47: Veritrans.PartialCapture:input_type -> PaymentRequest
+	6,  // 48: Veritrans.PartialCancel:input_type -> PaymentRequest
+	19, // 49: Veritrans.SearchOrders:input_type -> SearchRequest
+	1,  // 50: Veritrans.GetMDKToken:output_type -> TokenReply
+	3,  // 51: Veritrans.CreateAccount:output_type -> AccountReply
+	3,  // 52: Veritrans.UpdateAccount:output_type -> AccountReply
+	3,  // 53: Veritrans.GetAccount:output_type -> AccountReply
+	3,  // 54: Veritrans.DeleteAccount:output_type -> AccountReply
+	3,  // 55: Veritrans.RestoreAccount:output_type -> AccountReply
+	3,  // 56: Veritrans.CreateCard:output_type -> AccountReply
+	3,  // 57: Veritrans.UpdateCard:output_type -> AccountReply
+	3,  // 58: Veritrans.DeleteCard:output_type -> AccountReply
+	3,  // 59: Veritrans.GetCard:output_type -> AccountReply
+	5,  // 60: Veritrans.CreateRecurringCharge:output_type -> RecurringChargeReply
+	5,  // 61: Veritrans.UpdateRecurringCharge:output_type -> RecurringChargeReply
+	5,  // 62: Veritrans.DeleteRecurringCharge:output_type -> RecurringChargeReply
+	5,  // 63: Veritrans.GetRecurringCharge:output_type -> RecurringChargeReply
+	18, // 64: Veritrans.Authorize:output_type -> PaymentReply
+	18, // 65: Veritrans.Capture:output_type -> PaymentReply
+	18, // 66: Veritrans.Cancel:output_type -> PaymentReply
+	18, // 67: Veritrans.Refund:output_type -> PaymentReply
+	18, // 68: Veritrans.PayWithToken:output_type -> PaymentReply
+	18, // 69: Veritrans.ReAuthorize:output_type -> PaymentReply
+	18, // 70: Veritrans.PartialCapture:output_type -> PaymentReply
+	18, // 71: Veritrans.PartialCancel:output_type -> PaymentReply
+	20, // 72: Veritrans.SearchOrders:output_type -> SearchReply
+	50, // [50:73] is the sub-list for method output_type
+	27, // [27:50] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_veritrans_proto_init() }
@@ -923,7 +3759,7 @@ func file_veritrans_proto_init() {
 			}
 		}
 		file_veritrans_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PaymentRequest); i {
+			switch v := v.(*RecurringChargeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -935,7 +3771,7 @@ func file_veritrans_proto_init() {
 			}
 		}
 		file_veritrans_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PaymentReply); i {
+			switch v := v.(*RecurringChargeReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -947,7 +3783,7 @@ func file_veritrans_proto_init() {
 			}
 		}
 		file_veritrans_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccountRequest_CardParam); i {
+			switch v := v.(*PaymentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -959,7 +3795,7 @@ func file_veritrans_proto_init() {
 			}
 		}
 		file_veritrans_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccountReply_AccountInfo); i {
+			switch v := v.(*PaymentOption); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -971,7 +3807,7 @@ func file_veritrans_proto_init() {
 			}
 		}
 		file_veritrans_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccountReply_AccountInfo_CardInfo); i {
+			switch v := v.(*TokenPaymentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -983,7 +3819,7 @@ func file_veritrans_proto_init() {
 			}
 		}
 		file_veritrans_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PaymentRequest_PayNowIDParam); i {
+			switch v := v.(*ReAuthorizeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -995,6 +3831,222 @@ func file_veritrans_proto_init() {
 			}
 		}
 		file_veritrans_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CVSPaymentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_veritrans_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BankPaymentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_veritrans_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MPIPaymentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_veritrans_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaypalPaymentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_veritrans_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForeignPaymentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_veritrans_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CarrierPaymentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_veritrans_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EMPaymentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_veritrans_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaisonPaymentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_veritrans_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaymentReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_veritrans_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_veritrans_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_veritrans_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountRequest_CardParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_veritrans_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountRequest_AccountBasicParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_veritrans_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountReply_AccountInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_veritrans_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountReply_AccountInfo_CardInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_veritrans_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountReply_AccountInfo_AccountBasicInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_veritrans_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecurringChargeReply_RecurringCharge); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_veritrans_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaymentRequest_PayNowIDParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_veritrans_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PaymentRequest_PayNowIDParam_AccountParam); i {
 			case 0:
 				return &v.state
@@ -1006,19 +4058,93 @@ func file_veritrans_proto_init() {
 				return nil
 			}
 		}
+		file_veritrans_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaymentReply_TransactionResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_veritrans_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchReply_OrderInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_veritrans_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchReply_OrderInfo_TransactionInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_veritrans_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchReply_OrderInfo_ProperOrderInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_veritrans_proto_msgTypes[0].OneofWrappers = []interface{}{}
 	file_veritrans_proto_msgTypes[2].OneofWrappers = []interface{}{}
 	file_veritrans_proto_msgTypes[4].OneofWrappers = []interface{}{}
-	file_veritrans_proto_msgTypes[6].OneofWrappers = []interface{}{}
+	file_veritrans_proto_msgTypes[6].OneofWrappers = []interface{}{
+		(*PaymentRequest_Cvs)(nil),
+		(*PaymentRequest_Bank)(nil),
+		(*PaymentRequest_Mpi)(nil),
+		(*PaymentRequest_Paypal)(nil),
+		(*PaymentRequest_Foreign)(nil),
+		(*PaymentRequest_Carrier)(nil),
+		(*PaymentRequest_Em)(nil),
+		(*PaymentRequest_Saison)(nil),
+	}
+	file_veritrans_proto_msgTypes[7].OneofWrappers = []interface{}{}
+	file_veritrans_proto_msgTypes[8].OneofWrappers = []interface{}{}
 	file_veritrans_proto_msgTypes[9].OneofWrappers = []interface{}{}
+	file_veritrans_proto_msgTypes[10].OneofWrappers = []interface{}{}
+	file_veritrans_proto_msgTypes[11].OneofWrappers = []interface{}{}
+	file_veritrans_proto_msgTypes[12].OneofWrappers = []interface{}{}
+	file_veritrans_proto_msgTypes[13].OneofWrappers = []interface{}{}
+	file_veritrans_proto_msgTypes[14].OneofWrappers = []interface{}{}
+	file_veritrans_proto_msgTypes[15].OneofWrappers = []interface{}{}
+	file_veritrans_proto_msgTypes[16].OneofWrappers = []interface{}{}
+	file_veritrans_proto_msgTypes[17].OneofWrappers = []interface{}{}
+	file_veritrans_proto_msgTypes[19].OneofWrappers = []interface{}{}
+	file_veritrans_proto_msgTypes[21].OneofWrappers = []interface{}{}
+	file_veritrans_proto_msgTypes[22].OneofWrappers = []interface{}{}
+	file_veritrans_proto_msgTypes[23].OneofWrappers = []interface{}{}
+	file_veritrans_proto_msgTypes[27].OneofWrappers = []interface{}{}
+	file_veritrans_proto_msgTypes[29].OneofWrappers = []interface{}{}
+	file_veritrans_proto_msgTypes[30].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_veritrans_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
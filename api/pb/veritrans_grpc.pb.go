@@ -25,13 +25,26 @@ type VeritransClient interface {
 	GetMDKToken(ctx context.Context, in *GetMDKTokenRequest, opts ...grpc.CallOption) (*TokenReply, error)
 	CreateAccount(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*AccountReply, error)
 	UpdateAccount(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*AccountReply, error)
+	GetAccount(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*AccountReply, error)
+	DeleteAccount(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*AccountReply, error)
+	RestoreAccount(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*AccountReply, error)
 	CreateCard(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*AccountReply, error)
 	UpdateCard(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*AccountReply, error)
 	DeleteCard(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*AccountReply, error)
 	GetCard(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*AccountReply, error)
+	CreateRecurringCharge(ctx context.Context, in *RecurringChargeRequest, opts ...grpc.CallOption) (*RecurringChargeReply, error)
+	UpdateRecurringCharge(ctx context.Context, in *RecurringChargeRequest, opts ...grpc.CallOption) (*RecurringChargeReply, error)
+	DeleteRecurringCharge(ctx context.Context, in *RecurringChargeRequest, opts ...grpc.CallOption) (*RecurringChargeReply, error)
+	GetRecurringCharge(ctx context.Context, in *RecurringChargeRequest, opts ...grpc.CallOption) (*RecurringChargeReply, error)
 	Authorize(ctx context.Context, in *PaymentRequest, opts ...grpc.CallOption) (*PaymentReply, error)
 	Capture(ctx context.Context, in *PaymentRequest, opts ...grpc.CallOption) (*PaymentReply, error)
 	Cancel(ctx context.Context, in *PaymentRequest, opts ...grpc.CallOption) (*PaymentReply, error)
+	Refund(ctx context.Context, in *PaymentRequest, opts ...grpc.CallOption) (*PaymentReply, error)
+	PayWithToken(ctx context.Context, in *TokenPaymentRequest, opts ...grpc.CallOption) (*PaymentReply, error)
+	ReAuthorize(ctx context.Context, in *ReAuthorizeRequest, opts ...grpc.CallOption) (*PaymentReply, error)
+	PartialCapture(ctx context.Context, in *PaymentRequest, opts ...grpc.CallOption) (*PaymentReply, error)
+	PartialCancel(ctx context.Context, in *PaymentRequest, opts ...grpc.CallOption) (*PaymentReply, error)
+	SearchOrders(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchReply, error)
 }
 
 type veritransClient struct {
@@ -69,6 +82,33 @@ func (c *veritransClient) UpdateAccount(ctx context.Context, in *AccountRequest,
 	return out, nil
 }
 
+func (c *veritransClient) GetAccount(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*AccountReply, error) {
+	out := new(AccountReply)
+	err := c.cc.Invoke(ctx, "/Veritrans/GetAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *veritransClient) DeleteAccount(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*AccountReply, error) {
+	out := new(AccountReply)
+	err := c.cc.Invoke(ctx, "/Veritrans/DeleteAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *veritransClient) RestoreAccount(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*AccountReply, error) {
+	out := new(AccountReply)
+	err := c.cc.Invoke(ctx, "/Veritrans/RestoreAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *veritransClient) CreateCard(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*AccountReply, error) {
 	out := new(AccountReply)
 	err := c.cc.Invoke(ctx, "/Veritrans/CreateCard", in, out, opts...)
@@ -105,6 +145,42 @@ func (c *veritransClient) GetCard(ctx context.Context, in *AccountRequest, opts
 	return out, nil
 }
 
+func (c *veritransClient) CreateRecurringCharge(ctx context.Context, in *RecurringChargeRequest, opts ...grpc.CallOption) (*RecurringChargeReply, error) {
+	out := new(RecurringChargeReply)
+	err := c.cc.Invoke(ctx, "/Veritrans/CreateRecurringCharge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *veritransClient) UpdateRecurringCharge(ctx context.Context, in *RecurringChargeRequest, opts ...grpc.CallOption) (*RecurringChargeReply, error) {
+	out := new(RecurringChargeReply)
+	err := c.cc.Invoke(ctx, "/Veritrans/UpdateRecurringCharge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *veritransClient) DeleteRecurringCharge(ctx context.Context, in *RecurringChargeRequest, opts ...grpc.CallOption) (*RecurringChargeReply, error) {
+	out := new(RecurringChargeReply)
+	err := c.cc.Invoke(ctx, "/Veritrans/DeleteRecurringCharge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *veritransClient) GetRecurringCharge(ctx context.Context, in *RecurringChargeRequest, opts ...grpc.CallOption) (*RecurringChargeReply, error) {
+	out := new(RecurringChargeReply)
+	err := c.cc.Invoke(ctx, "/Veritrans/GetRecurringCharge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *veritransClient) Authorize(ctx context.Context, in *PaymentRequest, opts ...grpc.CallOption) (*PaymentReply, error) {
 	out := new(PaymentReply)
 	err := c.cc.Invoke(ctx, "/Veritrans/Authorize", in, out, opts...)
@@ -132,6 +208,60 @@ func (c *veritransClient) Cancel(ctx context.Context, in *PaymentRequest, opts .
 	return out, nil
 }
 
+func (c *veritransClient) Refund(ctx context.Context, in *PaymentRequest, opts ...grpc.CallOption) (*PaymentReply, error) {
+	out := new(PaymentReply)
+	err := c.cc.Invoke(ctx, "/Veritrans/Refund", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *veritransClient) PayWithToken(ctx context.Context, in *TokenPaymentRequest, opts ...grpc.CallOption) (*PaymentReply, error) {
+	out := new(PaymentReply)
+	err := c.cc.Invoke(ctx, "/Veritrans/PayWithToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *veritransClient) ReAuthorize(ctx context.Context, in *ReAuthorizeRequest, opts ...grpc.CallOption) (*PaymentReply, error) {
+	out := new(PaymentReply)
+	err := c.cc.Invoke(ctx, "/Veritrans/ReAuthorize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *veritransClient) PartialCapture(ctx context.Context, in *PaymentRequest, opts ...grpc.CallOption) (*PaymentReply, error) {
+	out := new(PaymentReply)
+	err := c.cc.Invoke(ctx, "/Veritrans/PartialCapture", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *veritransClient) PartialCancel(ctx context.Context, in *PaymentRequest, opts ...grpc.CallOption) (*PaymentReply, error) {
+	out := new(PaymentReply)
+	err := c.cc.Invoke(ctx, "/Veritrans/PartialCancel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *veritransClient) SearchOrders(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchReply, error) {
+	out := new(SearchReply)
+	err := c.cc.Invoke(ctx, "/Veritrans/SearchOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VeritransServer is the server API for Veritrans service.
 // All implementations must embed UnimplementedVeritransServer
 // for forward compatibility
@@ -139,13 +269,26 @@ type VeritransServer interface {
 	GetMDKToken(context.Context, *GetMDKTokenRequest) (*TokenReply, error)
 	CreateAccount(context.Context, *AccountRequest) (*AccountReply, error)
 	UpdateAccount(context.Context, *AccountRequest) (*AccountReply, error)
+	GetAccount(context.Context, *AccountRequest) (*AccountReply, error)
+	DeleteAccount(context.Context, *AccountRequest) (*AccountReply, error)
+	RestoreAccount(context.Context, *AccountRequest) (*AccountReply, error)
 	CreateCard(context.Context, *AccountRequest) (*AccountReply, error)
 	UpdateCard(context.Context, *AccountRequest) (*AccountReply, error)
 	DeleteCard(context.Context, *AccountRequest) (*AccountReply, error)
 	GetCard(context.Context, *AccountRequest) (*AccountReply, error)
+	CreateRecurringCharge(context.Context, *RecurringChargeRequest) (*RecurringChargeReply, error)
+	UpdateRecurringCharge(context.Context, *RecurringChargeRequest) (*RecurringChargeReply, error)
+	DeleteRecurringCharge(context.Context, *RecurringChargeRequest) (*RecurringChargeReply, error)
+	GetRecurringCharge(context.Context, *RecurringChargeRequest) (*RecurringChargeReply, error)
 	Authorize(context.Context, *PaymentRequest) (*PaymentReply, error)
 	Capture(context.Context, *PaymentRequest) (*PaymentReply, error)
 	Cancel(context.Context, *PaymentRequest) (*PaymentReply, error)
+	Refund(context.Context, *PaymentRequest) (*PaymentReply, error)
+	PayWithToken(context.Context, *TokenPaymentRequest) (*PaymentReply, error)
+	ReAuthorize(context.Context, *ReAuthorizeRequest) (*PaymentReply, error)
+	PartialCapture(context.Context, *PaymentRequest) (*PaymentReply, error)
+	PartialCancel(context.Context, *PaymentRequest) (*PaymentReply, error)
+	SearchOrders(context.Context, *SearchRequest) (*SearchReply, error)
 	mustEmbedUnimplementedVeritransServer()
 }
 
@@ -162,6 +305,15 @@ func (UnimplementedVeritransServer) CreateAccount(context.Context, *AccountReque
 func (UnimplementedVeritransServer) UpdateAccount(context.Context, *AccountRequest) (*AccountReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccount not implemented")
 }
+func (UnimplementedVeritransServer) GetAccount(context.Context, *AccountRequest) (*AccountReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccount not implemented")
+}
+func (UnimplementedVeritransServer) DeleteAccount(context.Context, *AccountRequest) (*AccountReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
+}
+func (UnimplementedVeritransServer) RestoreAccount(context.Context, *AccountRequest) (*AccountReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreAccount not implemented")
+}
 func (UnimplementedVeritransServer) CreateCard(context.Context, *AccountRequest) (*AccountReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateCard not implemented")
 }
@@ -174,6 +326,18 @@ func (UnimplementedVeritransServer) DeleteCard(context.Context, *AccountRequest)
 func (UnimplementedVeritransServer) GetCard(context.Context, *AccountRequest) (*AccountReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCard not implemented")
 }
+func (UnimplementedVeritransServer) CreateRecurringCharge(context.Context, *RecurringChargeRequest) (*RecurringChargeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRecurringCharge not implemented")
+}
+func (UnimplementedVeritransServer) UpdateRecurringCharge(context.Context, *RecurringChargeRequest) (*RecurringChargeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRecurringCharge not implemented")
+}
+func (UnimplementedVeritransServer) DeleteRecurringCharge(context.Context, *RecurringChargeRequest) (*RecurringChargeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecurringCharge not implemented")
+}
+func (UnimplementedVeritransServer) GetRecurringCharge(context.Context, *RecurringChargeRequest) (*RecurringChargeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecurringCharge not implemented")
+}
 func (UnimplementedVeritransServer) Authorize(context.Context, *PaymentRequest) (*PaymentReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Authorize not implemented")
 }
@@ -183,6 +347,24 @@ func (UnimplementedVeritransServer) Capture(context.Context, *PaymentRequest) (*
 func (UnimplementedVeritransServer) Cancel(context.Context, *PaymentRequest) (*PaymentReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Cancel not implemented")
 }
+func (UnimplementedVeritransServer) Refund(context.Context, *PaymentRequest) (*PaymentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Refund not implemented")
+}
+func (UnimplementedVeritransServer) PayWithToken(context.Context, *TokenPaymentRequest) (*PaymentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayWithToken not implemented")
+}
+func (UnimplementedVeritransServer) ReAuthorize(context.Context, *ReAuthorizeRequest) (*PaymentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReAuthorize not implemented")
+}
+func (UnimplementedVeritransServer) PartialCapture(context.Context, *PaymentRequest) (*PaymentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PartialCapture not implemented")
+}
+func (UnimplementedVeritransServer) PartialCancel(context.Context, *PaymentRequest) (*PaymentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PartialCancel not implemented")
+}
+func (UnimplementedVeritransServer) SearchOrders(context.Context, *SearchRequest) (*SearchReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchOrders not implemented")
+}
 func (UnimplementedVeritransServer) mustEmbedUnimplementedVeritransServer() {}
 
 // UnsafeVeritransServer may be embedded to opt out of forward compatibility for this service.
@@ -250,6 +432,60 @@ func _Veritrans_UpdateAccount_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Veritrans_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VeritransServer).GetAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Veritrans/GetAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VeritransServer).GetAccount(ctx, req.(*AccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Veritrans_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VeritransServer).DeleteAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Veritrans/DeleteAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VeritransServer).DeleteAccount(ctx, req.(*AccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Veritrans_RestoreAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VeritransServer).RestoreAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Veritrans/RestoreAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VeritransServer).RestoreAccount(ctx, req.(*AccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Veritrans_CreateCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AccountRequest)
 	if err := dec(in); err != nil {
@@ -322,6 +558,78 @@ func _Veritrans_GetCard_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Veritrans_CreateRecurringCharge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecurringChargeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VeritransServer).CreateRecurringCharge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Veritrans/CreateRecurringCharge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VeritransServer).CreateRecurringCharge(ctx, req.(*RecurringChargeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Veritrans_UpdateRecurringCharge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecurringChargeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VeritransServer).UpdateRecurringCharge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Veritrans/UpdateRecurringCharge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VeritransServer).UpdateRecurringCharge(ctx, req.(*RecurringChargeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Veritrans_DeleteRecurringCharge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecurringChargeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VeritransServer).DeleteRecurringCharge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Veritrans/DeleteRecurringCharge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VeritransServer).DeleteRecurringCharge(ctx, req.(*RecurringChargeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Veritrans_GetRecurringCharge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecurringChargeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VeritransServer).GetRecurringCharge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Veritrans/GetRecurringCharge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VeritransServer).GetRecurringCharge(ctx, req.(*RecurringChargeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Veritrans_Authorize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PaymentRequest)
 	if err := dec(in); err != nil {
@@ -376,6 +684,114 @@ func _Veritrans_Cancel_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Veritrans_Refund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VeritransServer).Refund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Veritrans/Refund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VeritransServer).Refund(ctx, req.(*PaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Veritrans_PayWithToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokenPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VeritransServer).PayWithToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Veritrans/PayWithToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VeritransServer).PayWithToken(ctx, req.(*TokenPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Veritrans_ReAuthorize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReAuthorizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VeritransServer).ReAuthorize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Veritrans/ReAuthorize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VeritransServer).ReAuthorize(ctx, req.(*ReAuthorizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Veritrans_PartialCapture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VeritransServer).PartialCapture(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Veritrans/PartialCapture",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VeritransServer).PartialCapture(ctx, req.(*PaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Veritrans_PartialCancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VeritransServer).PartialCancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Veritrans/PartialCancel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VeritransServer).PartialCancel(ctx, req.(*PaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Veritrans_SearchOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VeritransServer).SearchOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Veritrans/SearchOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VeritransServer).SearchOrders(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Veritrans_ServiceDesc is the grpc.ServiceDesc for Veritrans service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -395,6 +811,18 @@ var Veritrans_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateAccount",
 			Handler:    _Veritrans_UpdateAccount_Handler,
 		},
+		{
+			MethodName: "GetAccount",
+			Handler:    _Veritrans_GetAccount_Handler,
+		},
+		{
+			MethodName: "DeleteAccount",
+			Handler:    _Veritrans_DeleteAccount_Handler,
+		},
+		{
+			MethodName: "RestoreAccount",
+			Handler:    _Veritrans_RestoreAccount_Handler,
+		},
 		{
 			MethodName: "CreateCard",
 			Handler:    _Veritrans_CreateCard_Handler,
@@ -411,6 +839,22 @@ var Veritrans_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCard",
 			Handler:    _Veritrans_GetCard_Handler,
 		},
+		{
+			MethodName: "CreateRecurringCharge",
+			Handler:    _Veritrans_CreateRecurringCharge_Handler,
+		},
+		{
+			MethodName: "UpdateRecurringCharge",
+			Handler:    _Veritrans_UpdateRecurringCharge_Handler,
+		},
+		{
+			MethodName: "DeleteRecurringCharge",
+			Handler:    _Veritrans_DeleteRecurringCharge_Handler,
+		},
+		{
+			MethodName: "GetRecurringCharge",
+			Handler:    _Veritrans_GetRecurringCharge_Handler,
+		},
 		{
 			MethodName: "Authorize",
 			Handler:    _Veritrans_Authorize_Handler,
@@ -423,6 +867,30 @@ var Veritrans_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Cancel",
 			Handler:    _Veritrans_Cancel_Handler,
 		},
+		{
+			MethodName: "Refund",
+			Handler:    _Veritrans_Refund_Handler,
+		},
+		{
+			MethodName: "PayWithToken",
+			Handler:    _Veritrans_PayWithToken_Handler,
+		},
+		{
+			MethodName: "ReAuthorize",
+			Handler:    _Veritrans_ReAuthorize_Handler,
+		},
+		{
+			MethodName: "PartialCapture",
+			Handler:    _Veritrans_PartialCapture_Handler,
+		},
+		{
+			MethodName: "PartialCancel",
+			Handler:    _Veritrans_PartialCancel_Handler,
+		},
+		{
+			MethodName: "SearchOrders",
+			Handler:    _Veritrans_SearchOrders_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "veritrans.proto",
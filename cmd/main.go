@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"net/http"
@@ -16,6 +17,7 @@ import (
 	kitgrpc "github.com/go-kit/kit/transport/grpc"
 	"github.com/joho/godotenv"
 	"github.com/oklog/oklog/pkg/group"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 )
@@ -40,13 +42,33 @@ func main() {
 		logger.Log("read", "env", "err", err)
 	}
 
+	// cancelled on shutdown so that in-flight veritrans requests are aborted
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tracerProvider, err := pkg.NewTracerProvider(ctx, pkg.GetTracingConfig())
+	if err != nil {
+		logger.Log("tracing", "init", "err", err)
+		os.Exit(1)
+	}
+	defer tracerProvider.Shutdown(context.Background())
+
+	serviceConfig := pkg.GetServiceConfig()
+	serviceConfig.ConnectionConfig.Logger = log.With(logger, "component", "veritrans")
+
 	var (
-		service     = pkg.NewLoggingMiddleware(logger, pkg.NewService(pkg.GetServiceConfig()))
-		eps         = endpoint.NewEndpointSet(service)
+		metrics     = pkg.GetMetrics()
+		service     = pkg.NewLoggingMiddleware(logger, pkg.NewInstrumentingMiddleware(metrics, pkg.NewTracingMiddleware(pkg.NewService(serviceConfig))))
+		eps         = endpoint.NewEndpointSet(service).WithResilience(pkg.GetResilienceConfig())
 		httpHandler = transport.NewHTTPHandler(eps)
 		grpcServer  = transport.NewGRPCServer(eps)
 	)
 
+	// serve the metrics next to the api routes
+	httpMux := http.NewServeMux()
+	httpMux.Handle("/metrics", promhttp.Handler())
+	httpMux.Handle("/", httpHandler)
+
 	var g group.Group
 	{
 		httpListener, err := net.Listen("tcp", httpAddr)
@@ -54,11 +76,16 @@ func main() {
 			logger.Log("transport", "HTTP", "during", "Listen", "err", err)
 			os.Exit(1)
 		}
+		httpServer := &http.Server{
+			Handler:     httpMux,
+			BaseContext: func(net.Listener) context.Context { return ctx },
+		}
 		g.Add(func() error {
 			logger.Log("transport", "HTTP", "addr", httpAddr)
-			return http.Serve(httpListener, httpHandler)
+			return httpServer.Serve(httpListener)
 		}, func(error) {
-			httpListener.Close()
+			cancel()
+			httpServer.Close()
 		})
 	}
 
@@ -68,14 +95,15 @@ func main() {
 			logger.Log("transport", "gRPC", "during", "Listen", "err", err)
 			os.Exit(1)
 		}
+		baseServer := grpc.NewServer(grpc.UnaryInterceptor(kitgrpc.Interceptor))
 		g.Add(func() error {
 			logger.Log("transport", "gRPC", "addr", grpcAddr)
-			baseServer := grpc.NewServer(grpc.UnaryInterceptor(kitgrpc.Interceptor))
 			pb.RegisterVeritransServer(baseServer, grpcServer)
 			reflection.Register(baseServer)
 			return baseServer.Serve(grpcListener)
 		}, func(error) {
-			grpcListener.Close()
+			cancel()
+			baseServer.Stop()
 		})
 	}
 
@@ -1,7 +1,7 @@
 package veritrans
 
 import (
-	"errors"
+	"context"
 	"fmt"
 )
 
@@ -39,14 +39,22 @@ func (acc AccountService) getConnectionParam(accountParam *AccountParam) (*Conne
 }
 
 // Execute Account CRUD
-func (acc AccountService) executeAccountProcess(serviceType AccountServiceType, mode AccountManagementMode, accountParam *AccountParam) (*Account, error) {
+func (acc AccountService) executeAccountProcess(ctx context.Context, serviceType AccountServiceType, mode AccountManagementMode, accountParam *AccountParam) (*Account, error) {
 	connectionParam, err := acc.getConnectionParam(accountParam)
 	if err != nil {
 		return nil, err
 	}
 
-	accountRes, err := ProcessRequest(
-		fmt.Sprintf("%s/%s/%s", acc.Config.AccountAPIURL, AccountManagementModes[mode], AccountServiceTypes[serviceType]), connectionParam)
+	requestKind := fmt.Sprintf("%s/%s", AccountManagementModes[mode], AccountServiceTypes[serviceType])
+	requestURL := fmt.Sprintf("%s/%s", acc.Config.AccountAPIURL, requestKind)
+
+	var accountRes *ConnectionResponse
+	if mode == AccountManagementMode(MethodGet) {
+		// only the read requests are safe to retry
+		accountRes, err = acc.Config.processRequestWithRetry(ctx, requestURL, connectionParam, requestKind, nil)
+	} else {
+		accountRes, err = ProcessRequest(ctx, acc.Config.HTTPClient, requestURL, connectionParam)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -55,77 +63,191 @@ func (acc AccountService) executeAccountProcess(serviceType AccountServiceType,
 		return &accountRes.PayNowIDResponse.Account, nil
 	}
 
-	return nil, errors.New(accountRes.Result.MErrorMsg)
+	return nil, newAPIError(&accountRes.Result, requestKind)
 }
 
 // CreateAccount function
-func (acc AccountService) CreateAccount(accountParam *AccountParam) (*Account, error) {
+func (acc AccountService) CreateAccount(ctx context.Context, accountParam *AccountParam) (*Account, error) {
 	return acc.executeAccountProcess(
+		ctx,
 		AccountServiceType(AccountType),
 		AccountManagementMode(MethodAdd),
 		accountParam)
 }
 
 // UpdateAccount function
-func (acc AccountService) UpdateAccount(accountParam *AccountParam) (*Account, error) {
+func (acc AccountService) UpdateAccount(ctx context.Context, accountParam *AccountParam) (*Account, error) {
 	return acc.executeAccountProcess(
+		ctx,
 		AccountServiceType(AccountType),
 		AccountManagementMode(MethodUpdate),
 		accountParam)
 }
 
 // DeleteAccount function
-func (acc AccountService) DeleteAccount(accountParam *AccountParam) (*Account, error) {
+func (acc AccountService) DeleteAccount(ctx context.Context, accountParam *AccountParam) (*Account, error) {
+	if accountParam != nil && accountParam.AccountBasicParam != nil {
+		if err := validateAccountBasicParam(accountParam.AccountBasicParam); err != nil {
+			return nil, err
+		}
+		accountParam.AccountBasicParam.Default()
+	}
+
 	return acc.executeAccountProcess(
+		ctx,
 		AccountServiceType(AccountType),
 		AccountManagementMode(MethodDelete),
 		accountParam)
 }
 
 // GetAccount function
-func (acc AccountService) GetAccount(accountParam *AccountParam) (*Account, error) {
+func (acc AccountService) GetAccount(ctx context.Context, accountParam *AccountParam) (*Account, error) {
 	return acc.executeAccountProcess(
+		ctx,
 		AccountServiceType(AccountType),
 		AccountManagementMode(MethodGet),
 		accountParam)
 }
 
 // RestoreAccount function
-func (acc AccountService) RestoreAccount(accountParam *AccountParam) (*Account, error) {
+func (acc AccountService) RestoreAccount(ctx context.Context, accountParam *AccountParam) (*Account, error) {
 	return acc.executeAccountProcess(
+		ctx,
 		AccountServiceType(AccountType),
 		AccountManagementMode(MethodRestore),
 		accountParam)
 }
 
 // CreateCard function
-func (acc AccountService) CreateCard(accountParam *AccountParam) (*Account, error) {
+func (acc AccountService) CreateCard(ctx context.Context, accountParam *AccountParam) (*Account, error) {
 	return acc.executeAccountProcess(
+		ctx,
 		AccountServiceType(CardType),
 		AccountManagementMode(MethodAdd),
 		accountParam)
 }
 
 // DeleteCard function
-func (acc AccountService) DeleteCard(accountParam *AccountParam) (*Account, error) {
+func (acc AccountService) DeleteCard(ctx context.Context, accountParam *AccountParam) (*Account, error) {
 	return acc.executeAccountProcess(
+		ctx,
 		AccountServiceType(CardType),
 		AccountManagementMode(MethodDelete),
 		accountParam)
 }
 
 // UpdateCard function
-func (acc AccountService) UpdateCard(accountParam *AccountParam) (*Account, error) {
+func (acc AccountService) UpdateCard(ctx context.Context, accountParam *AccountParam) (*Account, error) {
 	return acc.executeAccountProcess(
+		ctx,
 		AccountServiceType(CardType),
 		AccountManagementMode(MethodUpdate),
 		accountParam)
 }
 
 // GetCard function
-func (acc AccountService) GetCard(accountParam *AccountParam) (*Account, error) {
+func (acc AccountService) GetCard(ctx context.Context, accountParam *AccountParam) (*Account, error) {
 	return acc.executeAccountProcess(
+		ctx,
 		AccountServiceType(CardType),
 		AccountManagementMode(MethodGet),
 		accountParam)
 }
+
+// CreateRecurringCharge function
+func (acc AccountService) CreateRecurringCharge(ctx context.Context, accountParam *AccountParam) (*Account, error) {
+	return acc.executeRecurringChargeProcess(ctx, AccountManagementMode(MethodAdd), accountParam)
+}
+
+// UpdateRecurringCharge function
+func (acc AccountService) UpdateRecurringCharge(ctx context.Context, accountParam *AccountParam) (*Account, error) {
+	return acc.executeRecurringChargeProcess(ctx, AccountManagementMode(MethodUpdate), accountParam)
+}
+
+// DeleteRecurringCharge function
+func (acc AccountService) DeleteRecurringCharge(ctx context.Context, accountParam *AccountParam) (*Account, error) {
+	return acc.executeRecurringChargeProcess(ctx, AccountManagementMode(MethodDelete), accountParam)
+}
+
+// GetRecurringCharge function
+func (acc AccountService) GetRecurringCharge(ctx context.Context, accountParam *AccountParam) (*Account, error) {
+	return acc.executeRecurringChargeProcess(ctx, AccountManagementMode(MethodGet), accountParam)
+}
+
+// Validate the recurring charge parameters and execute the request
+func (acc AccountService) executeRecurringChargeProcess(ctx context.Context, mode AccountManagementMode, accountParam *AccountParam) (*Account, error) {
+	if accountParam == nil || accountParam.RecurringChargeParam == nil {
+		return nil, &ValidationError{Field: "recurringChargeParam", Message: "required"}
+	}
+	if err := validateRecurringChargeParam(accountParam.RecurringChargeParam, mode, getToday()); err != nil {
+		return nil, err
+	}
+	if mode == AccountManagementMode(MethodAdd) || mode == AccountManagementMode(MethodUpdate) {
+		accountParam.RecurringChargeParam.Default()
+	}
+
+	return acc.executeAccountProcess(
+		ctx,
+		AccountServiceType(RecurringChargeType),
+		mode,
+		accountParam)
+}
+
+// Validate the deletion date and the force delete flag of the account
+func validateAccountBasicParam(accountBasicParam *AccountBasicParam) error {
+	if accountBasicParam.DeleteDate != "" && !isValidDate(accountBasicParam.DeleteDate) {
+		return &ValidationError{Field: "deleteDate", Message: "must be YYYYMMDD"}
+	}
+	switch accountBasicParam.ForceDeleteDate {
+	case "", "0", "1":
+	default:
+		return &ValidationError{Field: "forceDeleteDate", Message: "must be 0 or 1"}
+	}
+	return nil
+}
+
+// Validate the recurring charge parameters of the mode
+func validateRecurringChargeParam(param *RecurringChargeParam, mode AccountManagementMode, today string) error {
+	if param.GroupID == "" {
+		return &ValidationError{Field: "groupId", Message: "required"}
+	}
+	if mode == AccountManagementMode(MethodDelete) || mode == AccountManagementMode(MethodGet) {
+		return nil
+	}
+
+	if mode == AccountManagementMode(MethodAdd) && param.Amount == "" {
+		return &ValidationError{Field: "amount", Message: "required"}
+	}
+	if param.Amount != "" && !isValidAmount(param.Amount) {
+		return &ValidationError{Field: "amount", Message: "must be a positive integer"}
+	}
+	if param.OneTimeAmount != "" && !isValidAmount(param.OneTimeAmount) {
+		return &ValidationError{Field: "oneTimeAmount", Message: "must be a positive integer"}
+	}
+	switch param.FinalCharge {
+	case "", "0", "1":
+	default:
+		return &ValidationError{Field: "finalCharge", Message: "must be 0 or 1"}
+	}
+
+	if param.StartDate != "" {
+		if !isValidDate(param.StartDate) {
+			return &ValidationError{Field: "startDate", Message: "must be YYYYMMDD"}
+		}
+		if mode == AccountManagementMode(MethodAdd) && param.StartDate < today {
+			return &ValidationError{Field: "startDate", Message: "must not be in the past"}
+		}
+	}
+	if param.EndDate != "" {
+		if !isValidDate(param.EndDate) {
+			return &ValidationError{Field: "endDate", Message: "must be YYYYMMDD"}
+		}
+		if param.EndDate < today {
+			return &ValidationError{Field: "endDate", Message: "must not be in the past"}
+		}
+		if param.StartDate != "" && param.EndDate < param.StartDate {
+			return &ValidationError{Field: "endDate", Message: "must not be before the start date"}
+		}
+	}
+	return nil
+}
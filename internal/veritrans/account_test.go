@@ -1,6 +1,7 @@
 package veritrans
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -31,19 +32,20 @@ func init() {
 }
 
 func TestAccount(t *testing.T) {
+	ctx := context.Background()
 	testAccountID := "TEST_ACCOUNT_1"
 	accountParam := &AccountParam{
 		AccountID: testAccountID,
 	}
 
 	// Get Account
-	account, err := accountService.GetAccount(accountParam)
+	account, err := accountService.GetAccount(ctx, accountParam)
 	if err == nil {
 		// Assert if the account exists
 		assert.Equal(t, testAccountID, account.AccountID)
 	} else {
-		assert.Equal(t, "未登録の会員です。", err.Error())
-		account, err := accountService.CreateAccount(accountParam)
+		assert.True(t, IsAccountNotFound(err))
+		account, err := accountService.CreateAccount(ctx, accountParam)
 
 		// Create if the account doesn't exist
 		assert.Nil(t, err)
@@ -52,14 +54,14 @@ func TestAccount(t *testing.T) {
 	fmt.Println("Create Account Passed")
 
 	// Remove account
-	account, err = accountService.DeleteAccount(accountParam)
+	account, err = accountService.DeleteAccount(ctx, accountParam)
 
 	assert.Nil(t, err)
 	assert.Equal(t, testAccountID, account.AccountID)
 	fmt.Println("Remove Account Passed")
 
 	// Restore account
-	account, err = accountService.RestoreAccount(accountParam)
+	account, err = accountService.RestoreAccount(ctx, accountParam)
 
 	assert.Nil(t, err)
 	assert.Equal(t, testAccountID, account.AccountID)
@@ -67,19 +69,20 @@ func TestAccount(t *testing.T) {
 }
 
 func TestCard(t *testing.T) {
+	ctx := context.Background()
 	testAccountID := "TEST_ACCOUNT_2"
 	accountParam := &AccountParam{
 		AccountID: testAccountID,
 	}
 
 	// Get Account
-	account, err := accountService.GetAccount(accountParam)
+	account, err := accountService.GetAccount(ctx, accountParam)
 	if err == nil {
 		// Assert if the account exists
 		assert.Equal(t, testAccountID, account.AccountID)
 	} else {
-		assert.Equal(t, "未登録の会員です。", err.Error())
-		account, err := accountService.CreateAccount(accountParam)
+		assert.True(t, IsAccountNotFound(err))
+		account, err := accountService.CreateAccount(ctx, accountParam)
 
 		// Create if the account doesn't exist
 		assert.Nil(t, err)
@@ -96,14 +99,14 @@ func TestCard(t *testing.T) {
 		DefaultCard: "1",
 	}
 
-	account, err = accountService.CreateCard(accountParam)
+	account, err = accountService.CreateCard(ctx, accountParam)
 	assert.Nil(t, err)
 	assert.Equal(t, 1, len(account.CardInfo))
 	fmt.Println("Add The First Card Passed")
 
 	// Get Cards
 	accountParam.CardParam = nil
-	account, err = accountService.GetCard(accountParam)
+	account, err = accountService.GetCard(ctx, accountParam)
 
 	assert.Nil(t, err)
 	assert.Equal(t, 1, len(account.CardInfo))
@@ -122,14 +125,14 @@ func TestCard(t *testing.T) {
 		DefaultCard: "0",
 	}
 
-	account, err = accountService.CreateCard(accountParam)
+	account, err = accountService.CreateCard(ctx, accountParam)
 	assert.Nil(t, err)
 	secondCardID := account.CardInfo[0].CardID
 	fmt.Println("Add The Second Card Passed")
 
 	// Get Cards
 	accountParam.CardParam = nil
-	account, err = accountService.GetCard(accountParam)
+	account, err = accountService.GetCard(ctx, accountParam)
 	assert.Nil(t, err)
 	assert.Equal(t, 2, len(account.CardInfo))
 	assert.Equal(t, secondExpectedCardNumber, account.CardInfo[1].CardNumber)
@@ -142,7 +145,7 @@ func TestCard(t *testing.T) {
 		DefaultCard: "1",
 		CardExpire:  newExpiredAt,
 	}
-	account, err = accountService.UpdateCard(accountParam)
+	account, err = accountService.UpdateCard(ctx, accountParam)
 	assert.Nil(t, err)
 	assert.Equal(t, secondExpectedCardNumber, account.CardInfo[0].CardNumber)
 	assert.Equal(t, newExpiredAt, account.CardInfo[0].CardExpire)
@@ -152,19 +155,101 @@ func TestCard(t *testing.T) {
 	accountParam.CardParam = &CardParam{
 		CardID: firstCardID,
 	}
-	_, err = accountService.DeleteCard(accountParam)
+	_, err = accountService.DeleteCard(ctx, accountParam)
 	assert.Nil(t, err)
 
 	accountParam.CardParam = &CardParam{
 		CardID: secondCardID,
 	}
-	_, err = accountService.DeleteCard(accountParam)
+	_, err = accountService.DeleteCard(ctx, accountParam)
 	assert.Nil(t, err)
 
 	// Get Cards
 	accountParam.CardParam = nil
-	account, err = accountService.GetCard(accountParam)
+	account, err = accountService.GetCard(ctx, accountParam)
 	assert.Nil(t, err)
 	assert.Equal(t, 0, len(account.CardInfo))
 	fmt.Println("Remove Card Passed")
 }
+
+func TestValidateAccountBasicParam(t *testing.T) {
+	assert.Nil(t, validateAccountBasicParam(&AccountBasicParam{}))
+	assert.Nil(t, validateAccountBasicParam(&AccountBasicParam{DeleteDate: "20300101", ForceDeleteDate: "0"}))
+	assert.Nil(t, validateAccountBasicParam(&AccountBasicParam{ForceDeleteDate: "1"}))
+
+	err := validateAccountBasicParam(&AccountBasicParam{DeleteDate: "2030-01-01"})
+	assert.True(t, IsInvalidParameter(err))
+	err = validateAccountBasicParam(&AccountBasicParam{DeleteDate: "20301301"})
+	assert.True(t, IsInvalidParameter(err))
+	err = validateAccountBasicParam(&AccountBasicParam{ForceDeleteDate: "yes"})
+	assert.True(t, IsInvalidParameter(err))
+	err = validateAccountBasicParam(&AccountBasicParam{ForceDeleteDate: "20300101"})
+	assert.True(t, IsInvalidParameter(err))
+}
+
+func TestValidateRecurringChargeParam(t *testing.T) {
+	today := "20300101"
+	add := AccountManagementMode(MethodAdd)
+	update := AccountManagementMode(MethodUpdate)
+
+	assert.Nil(t, validateRecurringChargeParam(&RecurringChargeParam{
+		GroupID: "group", StartDate: "20300101", EndDate: "20301231", Amount: "1000", OneTimeAmount: "500",
+	}, add, today))
+	assert.Nil(t, validateRecurringChargeParam(&RecurringChargeParam{GroupID: "group"}, AccountManagementMode(MethodDelete), today))
+	assert.Nil(t, validateRecurringChargeParam(&RecurringChargeParam{GroupID: "group", StartDate: "20291231"}, update, today))
+
+	invalidParams := []*RecurringChargeParam{
+		{Amount: "1000"},
+		{GroupID: "group"},
+		{GroupID: "group", Amount: "-1"},
+		{GroupID: "group", Amount: "1000", OneTimeAmount: "abc"},
+		{GroupID: "group", Amount: "1000", FinalCharge: "2"},
+		{GroupID: "group", Amount: "1000", StartDate: "2030-01-01"},
+		{GroupID: "group", Amount: "1000", StartDate: "20291231"},
+		{GroupID: "group", Amount: "1000", EndDate: "20291231"},
+		{GroupID: "group", Amount: "1000", StartDate: "20300201", EndDate: "20300101"},
+	}
+	for _, param := range invalidParams {
+		err := validateRecurringChargeParam(param, add, today)
+		assert.True(t, IsInvalidParameter(err), "%+v", param)
+	}
+}
+
+func TestRecurringCharge(t *testing.T) {
+	groupID := os.Getenv("RECURRING_GROUP_ID")
+	if groupID == "" {
+		t.Skip("RECURRING_GROUP_ID is not set")
+	}
+
+	ctx := context.Background()
+	testAccountID := "TEST_ACCOUNT_3"
+	accountParam := &AccountParam{
+		AccountID: testAccountID,
+	}
+	if _, err := accountService.CreateAccount(ctx, accountParam); err != nil {
+		assert.True(t, IsAccountExists(err))
+	}
+
+	// Register recurring charge
+	accountParam.RecurringChargeParam = &RecurringChargeParam{
+		GroupID: groupID,
+		Amount:  "1000",
+	}
+	account, err := accountService.CreateRecurringCharge(ctx, accountParam)
+	assert.Nil(t, err)
+	assert.Equal(t, testAccountID, account.AccountID)
+
+	// Update recurring charge
+	accountParam.RecurringChargeParam.Amount = "2000"
+	_, err = accountService.UpdateRecurringCharge(ctx, accountParam)
+	assert.Nil(t, err)
+
+	// Get recurring charge
+	account, err = accountService.GetRecurringCharge(ctx, accountParam)
+	assert.Nil(t, err)
+	assert.Equal(t, testAccountID, account.AccountID)
+
+	// Stop recurring charge
+	_, err = accountService.DeleteRecurringCharge(ctx, accountParam)
+	assert.Nil(t, err)
+}
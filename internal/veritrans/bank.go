@@ -0,0 +1,82 @@
+package veritrans
+
+import (
+	"context"
+	"regexp"
+)
+
+// BankServiceOption is the enum type of the pay-easy payment options
+type BankServiceOption int32
+
+const (
+	// ATM indicates the "atm"
+	ATM BankServiceOption = iota
+	// NetBankPC indicates the "netbank-pc"
+	NetBankPC
+	// NetBankDocomo indicates the "netbank-docomo"
+	NetBankDocomo
+)
+
+// BankServiceOptions is a list of the pay-easy payment options
+var BankServiceOptions = []string{"atm", "netbank-pc", "netbank-docomo"}
+
+var kanaPattern = regexp.MustCompile(`^[\x{30A0}-\x{30FF}\x{FF66}-\x{FF9F} ]+$`)
+
+// AuthorizeBank requests the pay-easy payment and returns the payment numbers
+func (pay PaymentService) AuthorizeBank(ctx context.Context, param *Params) (*Result, error) {
+	if err := validateBankParams(param, getToday()); err != nil {
+		return nil, err
+	}
+	return pay.Authorize(ctx, param, PaymentServiceType(Bank))
+}
+
+// CancelBank cancels the pay-easy payment which is not paid yet
+func (pay PaymentService) CancelBank(ctx context.Context, param *Params) (*Result, error) {
+	if err := validateOrderID(param); err != nil {
+		return nil, err
+	}
+	return pay.Cancel(ctx, param, PaymentServiceType(Bank))
+}
+
+// Validate the pay-easy payment parameters
+func validateBankParams(param *Params, today string) error {
+	if err := validateOrderID(param); err != nil {
+		return err
+	}
+	if !isValidAmount(param.Amount) {
+		return &ValidationError{Field: "amount", Message: "must be a positive integer"}
+	}
+	if !containsString(BankServiceOptions, param.ServiceOptionType) {
+		return &ValidationError{Field: "serviceOptionType", Message: "must be one of atm, netbank-pc or netbank-docomo"}
+	}
+	if param.Name1 == "" {
+		return &ValidationError{Field: "name1", Message: "required"}
+	}
+	if param.Name2 == "" {
+		return &ValidationError{Field: "name2", Message: "required"}
+	}
+	if !kanaPattern.MatchString(param.Kana1) {
+		return &ValidationError{Field: "kana1", Message: "must be katakana"}
+	}
+	if !kanaPattern.MatchString(param.Kana2) {
+		return &ValidationError{Field: "kana2", Message: "must be katakana"}
+	}
+	if param.TelNo != "" && !telNoPattern.MatchString(param.TelNo) {
+		return &ValidationError{Field: "telNo", Message: "must be 10 or 11 digits"}
+	}
+	if !isValidDate(param.PayLimit) {
+		return &ValidationError{Field: "payLimit", Message: "must be YYYYMMDD"}
+	}
+	if param.PayLimit < today {
+		return &ValidationError{Field: "payLimit", Message: "must not be in the past"}
+	}
+	if param.ServiceOptionType == BankServiceOptions[ATM] {
+		if param.Contents == "" {
+			return &ValidationError{Field: "contents", Message: "required for atm"}
+		}
+		if !kanaPattern.MatchString(param.ContentsKana) {
+			return &ValidationError{Field: "contentsKana", Message: "must be katakana"}
+		}
+	}
+	return nil
+}
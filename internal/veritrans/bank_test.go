@@ -0,0 +1,106 @@
+package veritrans
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestValidateBankParams(t *testing.T) {
+	today := "20300101"
+	validParam := func() *Params {
+		return &Params{
+			OrderID:           "bank-order-1",
+			Amount:            "1000",
+			ServiceOptionType: BankServiceOptions[ATM],
+			Name1:             "Yamada",
+			Name2:             "Taro",
+			Kana1:             "ヤマダ",
+			Kana2:             "タロウ",
+			PayLimit:          "20300110",
+			Contents:          "Order",
+			ContentsKana:      "ゴチュウモン",
+		}
+	}
+	assert.Nil(t, validateBankParams(validParam(), today))
+
+	param := validParam()
+	param.ServiceOptionType = BankServiceOptions[NetBankPC]
+	param.Contents, param.ContentsKana = "", ""
+	assert.Nil(t, validateBankParams(param, today))
+
+	invalidParams := []func(*Params){
+		func(p *Params) { p.OrderID = "" },
+		func(p *Params) { p.Amount = "abc" },
+		func(p *Params) { p.ServiceOptionType = "card" },
+		func(p *Params) { p.Name1 = "" },
+		func(p *Params) { p.Name2 = "" },
+		func(p *Params) { p.Kana1 = "yamada" },
+		func(p *Params) { p.Kana2 = "" },
+		func(p *Params) { p.TelNo = "123" },
+		func(p *Params) { p.PayLimit = "" },
+		func(p *Params) { p.PayLimit = "20291231" },
+		func(p *Params) { p.Contents = "" },
+		func(p *Params) { p.ContentsKana = "order" },
+	}
+	for _, modify := range invalidParams {
+		param := validParam()
+		modify(param)
+		err := validateBankParams(param, today)
+		assert.True(t, IsInvalidParameter(err), "%+v", param)
+	}
+}
+
+func TestBank(t *testing.T) {
+	ctx := context.Background()
+
+	testOrderID, err := findNewOrderID()
+	assert.Nil(t, err)
+	fmt.Printf("Found New Order ID: %s\n", testOrderID)
+
+	// Authorize
+	result, err := paymentService.AuthorizeBank(ctx, &Params{
+		OrderID:           testOrderID,
+		Amount:            "100",
+		ServiceOptionType: BankServiceOptions[ATM],
+		Name1:             "Yamada",
+		Name2:             "Taro",
+		Kana1:             "ヤマダ",
+		Kana2:             "タロウ",
+		PayLimit:          time.Now().AddDate(0, 0, 7).Format("20060102"),
+		Contents:          "Order",
+		ContentsKana:      "ゴチュウモン",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, testOrderID, result.OrderID)
+	assert.NotEmpty(t, result.ShunoKikanNo)
+	assert.NotEmpty(t, result.CustomerNo)
+	assert.NotEmpty(t, result.ConfirmNo)
+	fmt.Println("Bank Authorize Passed")
+
+	// Search the pending bank payment
+	searchParam := Params{
+		SearchParam: &SearchParam{
+			Common: OrderParam{OrderID: testOrderID},
+		},
+	}
+	result, err = paymentService.Search(ctx, &searchParam, PaymentServiceType(Search))
+	assert.Nil(t, err)
+	assert.NotNil(t, result.OrderInfos)
+	assert.Equal(t, 1, len(result.OrderInfos.OrderInfo))
+	orderInfo := result.OrderInfos.OrderInfo[0]
+	assert.Equal(t, PaymentServiceTypes[Bank], orderInfo.ServiceTypeCd)
+	assert.NotNil(t, orderInfo.ProperOrderInfo)
+	assert.NotEmpty(t, orderInfo.ProperOrderInfo.CustomerNo)
+	assert.Empty(t, orderInfo.ProperOrderInfo.ReceivedDatetime)
+	fmt.Println("Bank Search Passed")
+
+	// Cancel
+	result, err = paymentService.CancelBank(ctx, &Params{OrderID: testOrderID})
+	assert.Nil(t, err)
+	assert.Equal(t, testOrderID, result.OrderID)
+	fmt.Println("Bank Cancel Passed")
+}
@@ -0,0 +1,115 @@
+package veritrans
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// AuthInfoKey is the key of the signature in the callback of the redirect payments
+const AuthInfoKey = "vAuthInfo"
+
+// GetAuthInfo returns the signature of the callback values
+// It is the sha256 hash of the merchant ccid, the sorted url encoded values except the signature and the password
+func GetAuthInfo(values url.Values, merchantID string, password string) string {
+	signed := url.Values{}
+	for key, value := range values {
+		if key != AuthInfoKey {
+			signed[key] = value
+		}
+	}
+
+	sha := sha256.New()
+	sha.Write([]byte(fmt.Sprintf("%s%s%s", merchantID, signed.Encode(), password)))
+	return fmt.Sprintf("%x", sha.Sum(nil))
+}
+
+// Add the values and their signature to the query of the url, so that the callback to the url can be verified
+// It is used for the callbacks which are not signed by veritrans
+func (pay PaymentService) withSignedQuery(rawURL string, values url.Values) string {
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	query := parsedURL.Query()
+	for key, value := range values {
+		query[key] = value
+	}
+	query.Set(AuthInfoKey, GetAuthInfo(values, pay.Config.MerchantCCID, pay.Config.MerchantPassword))
+	parsedURL.RawQuery = query.Encode()
+	return parsedURL.String()
+}
+
+// Verify the signature of the keys added to the callback url by withSignedQuery
+func (pay PaymentService) verifySignedQuery(values url.Values, keys ...string) error {
+	signed := url.Values{}
+	for _, key := range keys {
+		if value, ok := values[key]; ok {
+			signed[key] = value
+		}
+	}
+	authInfo := GetAuthInfo(signed, pay.Config.MerchantCCID, pay.Config.MerchantPassword)
+	if !hmac.Equal([]byte(values.Get(AuthInfoKey)), []byte(authInfo)) {
+		return &ValidationError{Field: AuthInfoKey, Message: "signature mismatch"}
+	}
+	return nil
+}
+
+// Keep the authorize parameters until the callback of the redirect payment
+func (pay PaymentService) savePendingState(param *Params, serviceType PaymentServiceType) {
+	pay.StateStore.Save(param.OrderID, PendingState{
+		Params:      *param,
+		ServiceType: serviceType,
+		ExpiresAt:   time.Now().Add(DefaultStateTTL),
+	})
+}
+
+// Get the pending state of the order which is waiting for the callback of the service
+func (pay PaymentService) getPendingState(orderID string, serviceType PaymentServiceType) (*PendingState, error) {
+	state, ok := pay.StateStore.Get(orderID)
+	if !ok || state.ServiceType != serviceType {
+		return nil, &ValidationError{Field: "orderId", Message: fmt.Sprintf("no pending %s payment", PaymentServiceTypes[serviceType])}
+	}
+	return state, nil
+}
+
+// Verify the signed callback and return the pending state and the result of the callback
+// The state is deleted here if the callback reports a failure, otherwise the caller deletes it once the payment is completed
+func (pay PaymentService) getCallbackState(values url.Values, serviceType PaymentServiceType) (*PendingState, *Result, error) {
+	authInfo := GetAuthInfo(values, pay.Config.MerchantCCID, pay.Config.MerchantPassword)
+	if !hmac.Equal([]byte(values.Get(AuthInfoKey)), []byte(authInfo)) {
+		return nil, nil, &ValidationError{Field: AuthInfoKey, Message: "signature mismatch"}
+	}
+
+	state, err := pay.getPendingState(values.Get("orderId"), serviceType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := &Result{
+		VResultCode: values.Get("vResultCode"),
+		MStatus:     values.Get("mstatus"),
+		MErrorMsg:   values.Get("merrMsg"),
+		OrderID:     state.Params.OrderID,
+		ServiceType: PaymentServiceTypes[serviceType],
+		CustTxn:     values.Get("custTxn"),
+		ReqAmount:   state.Params.Amount,
+	}
+	if result.MStatus != "success" {
+		pay.StateStore.Delete(state.Params.OrderID)
+		return nil, nil, newAPIError(result, fmt.Sprintf("%s/%s", PaymentManagementModes[MethodAuthorize], PaymentServiceTypes[serviceType]))
+	}
+	return state, result, nil
+}
+
+// Verify the signed callback which completes the payment by itself, and delete the pending state
+func (pay PaymentService) completeCallback(values url.Values, serviceType PaymentServiceType) (*Result, error) {
+	state, result, err := pay.getCallbackState(values, serviceType)
+	if err != nil {
+		return nil, err
+	}
+	pay.StateStore.Delete(state.Params.OrderID)
+	return result, nil
+}
@@ -0,0 +1,38 @@
+package veritrans
+
+import (
+	"net/url"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestGetAuthInfo(t *testing.T) {
+	values := url.Values{"orderId": {"order-1"}, "mstatus": {"success"}}
+	authInfo := GetAuthInfo(values, "ccid", "password")
+	assert.Equal(t, "ba7815d2f128a048c6ae82d7f24d2acb39828938d3f0c7491685452f940d1721", authInfo)
+
+	// the signature itself is not signed
+	values.Set(AuthInfoKey, authInfo)
+	assert.Equal(t, authInfo, GetAuthInfo(values, "ccid", "password"))
+
+	values.Set("mstatus", "failure")
+	assert.NotEqual(t, authInfo, GetAuthInfo(values, "ccid", "password"))
+	assert.NotEqual(t, authInfo, GetAuthInfo(url.Values{"orderId": {"order-1"}, "mstatus": {"success"}}, "ccid", "other"))
+}
+
+func TestSignedQuery(t *testing.T) {
+	pay := PaymentService{Config: ConnectionConfig{MerchantCCID: "ccid", MerchantPassword: "password"}}
+	signedURL, err := url.Parse(pay.withSignedQuery("https://example.com/callback?lang=ja", url.Values{"orderId": {"order-1"}}))
+	assert.Nil(t, err)
+
+	// the values added by the redirect are not signed
+	values := signedURL.Query()
+	values.Set("token", "EC-order-1")
+	assert.Equal(t, "ja", values.Get("lang"))
+	assert.Nil(t, pay.verifySignedQuery(values, "orderId"))
+
+	values.Set("orderId", "order-2")
+	assert.True(t, IsInvalidParameter(pay.verifySignedQuery(values, "orderId")))
+	assert.True(t, IsInvalidParameter(pay.verifySignedQuery(url.Values{"orderId": {"order-1"}}, "orderId")))
+}
@@ -0,0 +1,129 @@
+package veritrans
+
+import (
+	"context"
+	"net/url"
+)
+
+// CarrierServiceOption is the enum type of the carriers
+type CarrierServiceOption int32
+
+const (
+	// Docomo indicates the "docomo"
+	Docomo CarrierServiceOption = iota
+	// AU indicates the "au"
+	AU
+	// SoftBank indicates the "sb_ktai"
+	SoftBank
+)
+
+// CarrierServiceOptions is a list of the carriers
+var CarrierServiceOptions = []string{"docomo", "au", "sb_ktai"}
+
+// CarrierTerminalKind is the enum type of the customer devices
+type CarrierTerminalKind int32
+
+const (
+	// TerminalPC indicates the "0" (personal computer)
+	TerminalPC CarrierTerminalKind = iota
+	// TerminalSmartPhone indicates the "1" (smart phone)
+	TerminalSmartPhone
+	// TerminalFeaturePhone indicates the "2" (feature phone)
+	TerminalFeaturePhone
+)
+
+// CarrierTerminalKinds is a list of the customer devices
+var CarrierTerminalKinds = []string{"0", "1", "2"}
+
+// CarrierItemType is the enum type of the goods sold by the carrier billing
+type CarrierItemType int32
+
+const (
+	// ItemDigital indicates the "0" (digital contents)
+	ItemDigital CarrierItemType = iota
+	// ItemGoods indicates the "1" (physical goods)
+	ItemGoods
+	// ItemService indicates the "2" (services)
+	ItemService
+)
+
+// CarrierItemTypes is a list of the goods sold by the carrier billing
+var CarrierItemTypes = []string{"0", "1", "2"}
+
+// AuthorizeCarrier starts the carrier payment and returns the url (or the html on the feature phones) redirecting the customer to the carrier
+// The order ID is added to the success, cancel and error urls, so the callback can find the pending payment
+func (pay PaymentService) AuthorizeCarrier(ctx context.Context, param *Params) (*Result, error) {
+	if err := validateCarrierParams(param); err != nil {
+		return nil, err
+	}
+
+	authorizeParam := *param
+	authorizeParam.SuccessURL = withOrderID(param.SuccessURL, param.OrderID)
+	authorizeParam.CancelURL = withOrderID(param.CancelURL, param.OrderID)
+	authorizeParam.ErrorURL = withOrderID(param.ErrorURL, param.OrderID)
+	result, err := pay.Authorize(ctx, &authorizeParam, PaymentServiceType(Carrier))
+	if err != nil {
+		return nil, err
+	}
+
+	pay.savePendingState(param, PaymentServiceType(Carrier))
+	return result, nil
+}
+
+// CompleteCarrier verifies the callback of the customer returned from the carrier and returns the payment result
+func (pay PaymentService) CompleteCarrier(ctx context.Context, values url.Values) (*Result, error) {
+	result, err := pay.completeCallback(values, PaymentServiceType(Carrier))
+	if err != nil {
+		return nil, err
+	}
+	result.CarrierOrderID = values.Get("carrierOrderId")
+	return result, nil
+}
+
+// CaptureCarrier captures the authorized carrier payment
+func (pay PaymentService) CaptureCarrier(ctx context.Context, param *Params) (*Result, error) {
+	if err := validateOrderID(param); err != nil {
+		return nil, err
+	}
+	if param.Amount != "" && !isValidAmount(param.Amount) {
+		return nil, &ValidationError{Field: "amount", Message: "must be a positive integer"}
+	}
+	return pay.Capture(ctx, param, PaymentServiceType(Carrier))
+}
+
+// CancelCarrier cancels the carrier payment
+func (pay PaymentService) CancelCarrier(ctx context.Context, param *Params) (*Result, error) {
+	if err := validateOrderID(param); err != nil {
+		return nil, err
+	}
+	return pay.Cancel(ctx, param, PaymentServiceType(Carrier))
+}
+
+// Validate the carrier authorize parameters
+func validateCarrierParams(param *Params) error {
+	if err := validateOrderID(param); err != nil {
+		return err
+	}
+	if !isValidAmount(param.Amount) {
+		return &ValidationError{Field: "amount", Message: "must be a positive integer"}
+	}
+	if !containsString(CarrierServiceOptions, param.ServiceOptionType) {
+		return &ValidationError{Field: "serviceOptionType", Message: "must be one of docomo, au or sb_ktai"}
+	}
+	if !containsString(CarrierTerminalKinds, param.TerminalKind) {
+		return &ValidationError{Field: "terminalKind", Message: "must be 0 (pc), 1 (smart phone) or 2 (feature phone)"}
+	}
+	if !containsString(CarrierItemTypes, param.ItemType) {
+		return &ValidationError{Field: "itemType", Message: "must be 0 (digital), 1 (goods) or 2 (service)"}
+	}
+	if !isValidRedirectURL(param.SuccessURL) {
+		return &ValidationError{Field: "successUrl", Message: "must be an absolute url"}
+	}
+	if !isValidRedirectURL(param.CancelURL) {
+		return &ValidationError{Field: "cancelUrl", Message: "must be an absolute url"}
+	}
+	if !isValidRedirectURL(param.ErrorURL) {
+		return &ValidationError{Field: "errorUrl", Message: "must be an absolute url"}
+	}
+	return nil
+}
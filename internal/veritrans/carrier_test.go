@@ -0,0 +1,40 @@
+package veritrans
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestValidateCarrierParams(t *testing.T) {
+	validParam := func() *Params {
+		return &Params{
+			OrderID:           "carrier-order-1",
+			Amount:            "500",
+			ServiceOptionType: CarrierServiceOptions[Docomo],
+			TerminalKind:      CarrierTerminalKinds[TerminalSmartPhone],
+			ItemType:          CarrierItemTypes[ItemDigital],
+			SuccessURL:        "https://example.com/payment/carrier/callback",
+			CancelURL:         "https://example.com/payment/carrier/callback",
+			ErrorURL:          "https://example.com/payment/carrier/callback",
+		}
+	}
+	assert.Nil(t, validateCarrierParams(validParam()))
+
+	invalidParams := []func(*Params){
+		func(p *Params) { p.OrderID = "" },
+		func(p *Params) { p.Amount = "0" },
+		func(p *Params) { p.ServiceOptionType = "willcom" },
+		func(p *Params) { p.TerminalKind = "" },
+		func(p *Params) { p.ItemType = "3" },
+		func(p *Params) { p.SuccessURL = "/success" },
+		func(p *Params) { p.CancelURL = "" },
+		func(p *Params) { p.ErrorURL = "ftp://example.com/error" },
+	}
+	for _, modify := range invalidParams {
+		param := validParam()
+		modify(param)
+		err := validateCarrierParams(param)
+		assert.True(t, IsInvalidParameter(err), "%+v", param)
+	}
+}
@@ -0,0 +1,90 @@
+package veritrans
+
+import (
+	"crypto/tls"
+	"io"
+	"net"
+	"net/http"
+	"time"
+)
+
+// ClientConfig is a configuration of the http client for the veritrans apis
+// ConnectTimeout is the timeout for establishing a connection
+// ReadTimeout is the timeout for waiting the response headers after the request is written
+// Timeout is the total timeout of a request including reading the response body
+// TLSMinVersion is the minimum TLS version (tls.VersionTLS12 by default)
+type ClientConfig struct {
+	ConnectTimeout      time.Duration
+	ReadTimeout         time.Duration
+	Timeout             time.Duration
+	KeepAlive           time.Duration
+	MaxIdleConns        int
+	MaxIdleConnsPerHost int
+	MaxConnsPerHost     int
+	IdleConnTimeout     time.Duration
+	TLSHandshakeTimeout time.Duration
+	TLSMinVersion       uint16
+	InsecureSkipVerify  bool
+}
+
+// DefaultClientConfig returns the default configuration of the http client
+func DefaultClientConfig() ClientConfig {
+	return ClientConfig{
+		ConnectTimeout:      5 * time.Second,
+		ReadTimeout:         30 * time.Second,
+		Timeout:             60 * time.Second,
+		KeepAlive:           30 * time.Second,
+		MaxIdleConns:        100,
+		MaxIdleConnsPerHost: 10,
+		IdleConnTimeout:     90 * time.Second,
+		TLSHandshakeTimeout: 10 * time.Second,
+		TLSMinVersion:       tls.VersionTLS12,
+	}
+}
+
+// NewHTTPClient initializes a http client with the connection pool and timeouts
+func NewHTTPClient(config ClientConfig) *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   config.ConnectTimeout,
+		KeepAlive: config.KeepAlive,
+	}
+
+	transport := &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		DialContext:           dialer.DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          config.MaxIdleConns,
+		MaxIdleConnsPerHost:   config.MaxIdleConnsPerHost,
+		MaxConnsPerHost:       config.MaxConnsPerHost,
+		IdleConnTimeout:       config.IdleConnTimeout,
+		TLSHandshakeTimeout:   config.TLSHandshakeTimeout,
+		ResponseHeaderTimeout: config.ReadTimeout,
+		ExpectContinueTimeout: 1 * time.Second,
+		TLSClientConfig: &tls.Config{
+			MinVersion:         config.TLSMinVersion,
+			InsecureSkipVerify: config.InsecureSkipVerify,
+		},
+	}
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   config.Timeout,
+	}
+}
+
+// defaultHTTPClient is shared by the services which are not given a client
+var defaultHTTPClient = NewHTTPClient(DefaultClientConfig())
+
+// Get the given http client or the shared default client
+func getHTTPClient(httpClient *http.Client) *http.Client {
+	if httpClient == nil {
+		return defaultHTTPClient
+	}
+	return httpClient
+}
+
+// Drain and close the response body so that the connection can be reused
+func drainBody(body io.ReadCloser) {
+	io.Copy(io.Discard, io.LimitReader(body, 64*1024))
+	body.Close()
+}
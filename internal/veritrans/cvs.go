@@ -0,0 +1,71 @@
+package veritrans
+
+import (
+	"context"
+	"regexp"
+)
+
+// CVSServiceOption is the enum type of the convenience store chains
+type CVSServiceOption int32
+
+const (
+	// SevenEleven indicates the "sej"
+	SevenEleven CVSServiceOption = iota
+	// ECON indicates the "econ" (Lawson, FamilyMart, Ministop and Seicomart)
+	ECON
+	// OtherCVS indicates the "other" (Daily Yamazaki and Yamazaki Daily Store)
+	OtherCVS
+)
+
+// CVSServiceOptions is a list of the convenience store chains
+var CVSServiceOptions = []string{"sej", "econ", "other"}
+
+var (
+	telNoPattern = regexp.MustCompile(`^[0-9]{10,11}$`)
+	hhmmPattern  = regexp.MustCompile(`^([01][0-9]|2[0-3])[0-5][0-9]$`)
+)
+
+// AuthorizeCVS requests the convenience store payment and returns the receipt number
+func (pay PaymentService) AuthorizeCVS(ctx context.Context, param *Params) (*Result, error) {
+	if err := validateCVSParams(param, getToday()); err != nil {
+		return nil, err
+	}
+	return pay.Authorize(ctx, param, PaymentServiceType(CVS))
+}
+
+// CancelCVS cancels the convenience store payment which is not paid yet
+func (pay PaymentService) CancelCVS(ctx context.Context, param *Params) (*Result, error) {
+	if err := validateOrderID(param); err != nil {
+		return nil, err
+	}
+	return pay.Cancel(ctx, param, PaymentServiceType(CVS))
+}
+
+// Validate the convenience store payment parameters
+func validateCVSParams(param *Params, today string) error {
+	if err := validateOrderID(param); err != nil {
+		return err
+	}
+	if !isValidAmount(param.Amount) {
+		return &ValidationError{Field: "amount", Message: "must be a positive integer"}
+	}
+	if !containsString(CVSServiceOptions, param.ServiceOptionType) {
+		return &ValidationError{Field: "serviceOptionType", Message: "must be one of sej, econ or other"}
+	}
+	if param.Name1 == "" {
+		return &ValidationError{Field: "name1", Message: "required"}
+	}
+	if !telNoPattern.MatchString(param.TelNo) {
+		return &ValidationError{Field: "telNo", Message: "must be 10 or 11 digits"}
+	}
+	if !isValidDate(param.PayLimit) {
+		return &ValidationError{Field: "payLimit", Message: "must be YYYYMMDD"}
+	}
+	if param.PayLimit < today {
+		return &ValidationError{Field: "payLimit", Message: "must not be in the past"}
+	}
+	if param.PayLimitHhmm != "" && !hhmmPattern.MatchString(param.PayLimitHhmm) {
+		return &ValidationError{Field: "payLimitHhmm", Message: "must be HHMM"}
+	}
+	return nil
+}
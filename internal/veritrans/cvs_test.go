@@ -0,0 +1,75 @@
+package veritrans
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestValidateCVSParams(t *testing.T) {
+	today := "20300101"
+	validParam := func() *Params {
+		return &Params{
+			OrderID:           "cvs-order-1",
+			Amount:            "1000",
+			ServiceOptionType: CVSServiceOptions[SevenEleven],
+			Name1:             "Taro",
+			TelNo:             "0312345678",
+			PayLimit:          "20300110",
+		}
+	}
+	assert.Nil(t, validateCVSParams(validParam(), today))
+
+	param := validParam()
+	param.PayLimit = today
+	param.PayLimitHhmm = "2359"
+	assert.Nil(t, validateCVSParams(param, today))
+
+	invalidParams := []func(*Params){
+		func(p *Params) { p.OrderID = "" },
+		func(p *Params) { p.Amount = "0" },
+		func(p *Params) { p.ServiceOptionType = "lawson" },
+		func(p *Params) { p.Name1 = "" },
+		func(p *Params) { p.TelNo = "03-1234-5678" },
+		func(p *Params) { p.PayLimit = "2030-01-10" },
+		func(p *Params) { p.PayLimit = "20291231" },
+		func(p *Params) { p.PayLimitHhmm = "2400" },
+	}
+	for _, modify := range invalidParams {
+		param := validParam()
+		modify(param)
+		err := validateCVSParams(param, today)
+		assert.True(t, IsInvalidParameter(err), "%+v", param)
+	}
+}
+
+func TestCVS(t *testing.T) {
+	ctx := context.Background()
+
+	testOrderID, err := findNewOrderID()
+	assert.Nil(t, err)
+	fmt.Printf("Found New Order ID: %s\n", testOrderID)
+
+	// Authorize
+	result, err := paymentService.AuthorizeCVS(ctx, &Params{
+		OrderID:           testOrderID,
+		Amount:            "100",
+		ServiceOptionType: CVSServiceOptions[SevenEleven],
+		Name1:             "Taro",
+		TelNo:             "0312345678",
+		PayLimit:          time.Now().AddDate(0, 0, 7).Format("20060102"),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, testOrderID, result.OrderID)
+	assert.NotEmpty(t, result.ReceiptNo)
+	fmt.Println("CVS Authorize Passed")
+
+	// Cancel
+	result, err = paymentService.CancelCVS(ctx, &Params{OrderID: testOrderID})
+	assert.Nil(t, err)
+	assert.Equal(t, testOrderID, result.OrderID)
+	fmt.Println("CVS Cancel Passed")
+}
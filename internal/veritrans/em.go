@@ -0,0 +1,93 @@
+package veritrans
+
+import (
+	"context"
+	"regexp"
+	"strings"
+)
+
+// EMServiceOption is the enum type of the electronic money services
+type EMServiceOption int32
+
+const (
+	// EdyMobile indicates the "edy-mobile" (Rakuten Edy on the mobile phone)
+	EdyMobile EMServiceOption = iota
+	// EdyPC indicates the "edy-pc" (Rakuten Edy with the card reader)
+	EdyPC
+	// SuicaMobileMail indicates the "suica-mobile-mail" (Mobile Suica with the payment mail)
+	SuicaMobileMail
+	// SuicaPCMail indicates the "suica-pc-mail" (Suica on the pc with the payment mail)
+	SuicaPCMail
+	// SuicaMobileApp indicates the "suica-mobile-app" (Mobile Suica with the app link)
+	SuicaMobileApp
+	// SuicaPCApp indicates the "suica-pc-app" (Suica on the pc with the app link)
+	SuicaPCApp
+	// WAONMobile indicates the "waon-mobile" (mobile WAON)
+	WAONMobile
+	// WAONPC indicates the "waon-pc" (WAON with the card reader)
+	WAONPC
+)
+
+// EMServiceOptions is a list of the electronic money services
+var EMServiceOptions = []string{
+	"edy-mobile", "edy-pc", "suica-mobile-mail", "suica-pc-mail", "suica-mobile-app", "suica-pc-app", "waon-mobile", "waon-pc",
+}
+
+var mailAddrPattern = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+
+// AuthorizeEM requests the electronic money payment and returns the app link or the mail link
+func (pay PaymentService) AuthorizeEM(ctx context.Context, param *Params) (*Result, error) {
+	if err := validateEMParams(param); err != nil {
+		return nil, err
+	}
+	return pay.Authorize(ctx, param, PaymentServiceType(EM))
+}
+
+// CancelEM cancels the electronic money payment which is not paid yet
+func (pay PaymentService) CancelEM(ctx context.Context, param *Params) (*Result, error) {
+	if err := validateOrderID(param); err != nil {
+		return nil, err
+	}
+	return pay.Cancel(ctx, param, PaymentServiceType(EM))
+}
+
+// RefundEM refunds the paid electronic money payment (partially if the amount is given)
+func (pay PaymentService) RefundEM(ctx context.Context, param *Params) (*Result, error) {
+	if err := validateOrderID(param); err != nil {
+		return nil, err
+	}
+	if param.Amount != "" && !isValidAmount(param.Amount) {
+		return nil, &ValidationError{Field: "amount", Message: "must be a positive integer"}
+	}
+	return pay.Refund(ctx, param, PaymentServiceType(EM))
+}
+
+// Validate the electronic money payment parameters
+// The mail address is required when the payment link is sent by mail
+func validateEMParams(param *Params) error {
+	if err := validateOrderID(param); err != nil {
+		return err
+	}
+	if !isValidAmount(param.Amount) {
+		return &ValidationError{Field: "amount", Message: "must be a positive integer"}
+	}
+	if !containsString(EMServiceOptions, param.ServiceOptionType) {
+		return &ValidationError{Field: "serviceOptionType", Message: "must be one of " + strings.Join(EMServiceOptions, ", ")}
+	}
+	if strings.HasSuffix(param.ServiceOptionType, "-mail") && param.MailAddr == "" {
+		return &ValidationError{Field: "mailAddr", Message: "required for " + param.ServiceOptionType}
+	}
+	if param.MailAddr != "" && !mailAddrPattern.MatchString(param.MailAddr) {
+		return &ValidationError{Field: "mailAddr", Message: "must be a mail address"}
+	}
+	if param.SuccessURL != "" && !isValidRedirectURL(param.SuccessURL) {
+		return &ValidationError{Field: "successUrl", Message: "must be an absolute url"}
+	}
+	if param.CancelURL != "" && !isValidRedirectURL(param.CancelURL) {
+		return &ValidationError{Field: "cancelUrl", Message: "must be an absolute url"}
+	}
+	if param.ErrorURL != "" && !isValidRedirectURL(param.ErrorURL) {
+		return &ValidationError{Field: "errorUrl", Message: "must be an absolute url"}
+	}
+	return nil
+}
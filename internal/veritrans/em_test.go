@@ -0,0 +1,38 @@
+package veritrans
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestValidateEMParams(t *testing.T) {
+	validParam := func() *Params {
+		return &Params{
+			OrderID:           "em-order-1",
+			Amount:            "1000",
+			ServiceOptionType: EMServiceOptions[SuicaMobileMail],
+			MailAddr:          "customer@example.com",
+		}
+	}
+	assert.Nil(t, validateEMParams(validParam()))
+
+	param := validParam()
+	param.ServiceOptionType, param.MailAddr = EMServiceOptions[SuicaMobileApp], ""
+	assert.Nil(t, validateEMParams(param))
+
+	invalidParams := []func(*Params){
+		func(p *Params) { p.OrderID = "" },
+		func(p *Params) { p.Amount = "" },
+		func(p *Params) { p.ServiceOptionType = "nanaco" },
+		func(p *Params) { p.MailAddr = "" },
+		func(p *Params) { p.MailAddr = "customer.example.com" },
+		func(p *Params) { p.SuccessURL = "/success" },
+	}
+	for _, modify := range invalidParams {
+		param := validParam()
+		modify(param)
+		err := validateEMParams(param)
+		assert.True(t, IsInvalidParameter(err), "%+v", param)
+	}
+}
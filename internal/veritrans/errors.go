@@ -0,0 +1,196 @@
+package veritrans
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrorCategory is the enum type of the veritrans error categories
+type ErrorCategory int32
+
+const (
+	// CategoryUnknown indicates an unclassified error
+	CategoryUnknown ErrorCategory = iota
+	// CategoryCardDeclined indicates the card was declined by the card company
+	CategoryCardDeclined
+	// CategoryInvalidParameter indicates the request parameters are invalid
+	CategoryInvalidParameter
+	// CategoryDuplicateOrder indicates the order ID is already used
+	CategoryDuplicateOrder
+	// CategoryAccountNotFound indicates the account is not registered
+	CategoryAccountNotFound
+	// CategoryAccountExists indicates the account is already registered
+	CategoryAccountExists
+	// CategorySystemError indicates an error inside veritrans
+	CategorySystemError
+	// CategoryRetryable indicates a temporary failure which can be retried
+	CategoryRetryable
+)
+
+// ErrorCategories is a list of error categories
+var ErrorCategories = []string{
+	"unknown",
+	"card_declined",
+	"invalid_parameter",
+	"duplicate_order",
+	"account_not_found",
+	"account_exists",
+	"system_error",
+	"retryable",
+}
+
+// String returns the name of the error category
+func (category ErrorCategory) String() string {
+	if category < 0 || int(category) >= len(ErrorCategories) {
+		return ErrorCategories[CategoryUnknown]
+	}
+	return ErrorCategories[category]
+}
+
+// resultCodeCategory maps a vResultCode prefix to the error category
+type resultCodeCategory struct {
+	prefix   string
+	category ErrorCategory
+}
+
+// resultCodeCategories is the classification table of the vResultCode.
+// The first four characters of the vResultCode identify the result, and the
+// first two characters identify its family, so the longest prefix is matched first.
+var resultCodeCategories = []resultCodeCategory{
+	// order ID already used
+	{"NH18", CategoryDuplicateOrder},
+	// paynowid member not registered
+	{"NC11", CategoryAccountNotFound},
+	// paynowid member already registered
+	{"NC12", CategoryAccountExists},
+	// veritrans system busy or under maintenance
+	{"NE01", CategoryRetryable},
+	{"NE02", CategoryRetryable},
+	// authorization declined by the card company
+	{"AG", CategoryCardDeclined},
+	// 3-D secure authentication failed
+	{"G", CategoryCardDeclined},
+	// request parameter errors
+	{"MA", CategoryInvalidParameter},
+	{"MF", CategoryInvalidParameter},
+	// veritrans system errors
+	{"NE", CategorySystemError},
+	{"NF", CategorySystemError},
+	{"NG", CategorySystemError},
+}
+
+// GetResultCodeCategory classifies the vResultCode
+func GetResultCodeCategory(vResultCode string) ErrorCategory {
+	matched := ""
+	category := CategoryUnknown
+	for _, item := range resultCodeCategories {
+		if strings.HasPrefix(vResultCode, item.prefix) && len(item.prefix) > len(matched) {
+			matched = item.prefix
+			category = item.category
+		}
+	}
+	return category
+}
+
+// APIError represents a failure result returned by the veritrans api
+// Kind is the kind of the request (e.g. Authorize/card)
+type APIError struct {
+	VResultCode string
+	MStatus     string
+	Message     string
+	Kind        string
+}
+
+// Error returns the error message
+func (e *APIError) Error() string {
+	if e.Message != "" {
+		return e.Message
+	}
+	return fmt.Sprintf("%s failed with %s (%s)", e.Kind, e.MStatus, e.VResultCode)
+}
+
+// Category returns the category of the error
+func (e *APIError) Category() ErrorCategory {
+	return GetResultCodeCategory(e.VResultCode)
+}
+
+// Get the api error from the result
+func newAPIError(result *Result, kind string) *APIError {
+	return &APIError{
+		VResultCode: result.VResultCode,
+		MStatus:     result.MStatus,
+		Message:     result.MErrorMsg,
+		Kind:        kind,
+	}
+}
+
+// HTTPError represents an unexpected http status of the veritrans api
+type HTTPError struct {
+	StatusCode int
+	URL        string
+}
+
+// Error returns the error message
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("veritrans api %s responded with status %d", e.URL, e.StatusCode)
+}
+
+// ValidationError represents an invalid request parameter rejected before calling the veritrans api
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+// Error returns the error message
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid %s: %s", e.Field, e.Message)
+}
+
+// GetErrorCategory returns the category of the error
+func GetErrorCategory(err error) ErrorCategory {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Category()
+	}
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) {
+		return CategoryInvalidParameter
+	}
+	return CategoryUnknown
+}
+
+// IsCardDeclined checks if the card was declined
+func IsCardDeclined(err error) bool {
+	return GetErrorCategory(err) == CategoryCardDeclined
+}
+
+// IsInvalidParameter checks if the request parameters are invalid
+func IsInvalidParameter(err error) bool {
+	return GetErrorCategory(err) == CategoryInvalidParameter
+}
+
+// IsDuplicateOrder checks if the order ID is already used
+func IsDuplicateOrder(err error) bool {
+	return GetErrorCategory(err) == CategoryDuplicateOrder
+}
+
+// IsAccountNotFound checks if the account is not registered
+func IsAccountNotFound(err error) bool {
+	return GetErrorCategory(err) == CategoryAccountNotFound
+}
+
+// IsAccountExists checks if the account is already registered
+func IsAccountExists(err error) bool {
+	return GetErrorCategory(err) == CategoryAccountExists
+}
+
+// IsSystemError checks if the error occurred inside veritrans
+func IsSystemError(err error) bool {
+	return GetErrorCategory(err) == CategorySystemError
+}
+
+// IsRetryable checks if the request can be retried
+func IsRetryable(err error) bool {
+	return GetErrorCategory(err) == CategoryRetryable
+}
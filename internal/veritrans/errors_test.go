@@ -0,0 +1,48 @@
+package veritrans
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestResultCodeCategory(t *testing.T) {
+	testCases := []struct {
+		vResultCode string
+		category    ErrorCategory
+	}{
+		{"AG33000000000000", CategoryCardDeclined},
+		{"G012000000000000", CategoryCardDeclined},
+		{"MA01000000000000", CategoryInvalidParameter},
+		{"NH18000000000000", CategoryDuplicateOrder},
+		{"NC11000000000000", CategoryAccountNotFound},
+		{"NC12000000000000", CategoryAccountExists},
+		{"NE01000000000000", CategoryRetryable},
+		{"NE10000000000000", CategorySystemError},
+		{"A001000000000000", CategoryUnknown},
+		{"", CategoryUnknown},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.category, GetResultCodeCategory(testCase.vResultCode), testCase.vResultCode)
+	}
+}
+
+func TestAPIError(t *testing.T) {
+	err := fmt.Errorf("wrapped: %w", &APIError{
+		VResultCode: "NC11000000000000",
+		MStatus:     "failure",
+		Message:     "未登録の会員です。",
+		Kind:        "Get/account",
+	})
+
+	assert.True(t, IsAccountNotFound(err))
+	assert.False(t, IsAccountExists(err))
+	assert.Equal(t, "account_not_found", GetErrorCategory(err).String())
+	assert.Equal(t, CategoryUnknown, GetErrorCategory(errors.New("network error")))
+
+	noMessageErr := &APIError{VResultCode: "AG33000000000000", MStatus: "failure", Kind: "Authorize/card"}
+	assert.Equal(t, "Authorize/card failed with failure (AG33000000000000)", noMessageErr.Error())
+}
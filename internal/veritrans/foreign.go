@@ -0,0 +1,139 @@
+package veritrans
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Currency is the enum type of the currencies
+type Currency int32
+
+const (
+	// JPY indicates the japanese yen
+	JPY Currency = iota
+	// CNY indicates the chinese yuan
+	CNY
+)
+
+// Currencies is a list of the currencies
+var Currencies = []string{"JPY", "CNY"}
+
+// AlipayCurrencies is a list of the currencies accepted by alipay
+var AlipayCurrencies = []string{Currencies[JPY], Currencies[CNY]}
+
+// UPopCurrencies is a list of the currencies accepted by unionpay
+var UPopCurrencies = []string{Currencies[JPY]}
+
+var decimalAmountPattern = regexp.MustCompile(`^[0-9]+(\.[0-9]{1,2})?$`)
+
+// AuthorizeAlipay starts the alipay payment and returns the url redirecting the customer to alipay
+func (pay PaymentService) AuthorizeAlipay(ctx context.Context, param *Params) (*Result, error) {
+	return pay.authorizeForeign(ctx, param, PaymentServiceType(Alipay), AlipayCurrencies)
+}
+
+// CompleteAlipay verifies the callback of the customer returned from alipay and returns the payment result
+func (pay PaymentService) CompleteAlipay(ctx context.Context, values url.Values) (*Result, error) {
+	return pay.completeCallback(values, PaymentServiceType(Alipay))
+}
+
+// RefundAlipay refunds the alipay payment (partially if the amount is given)
+func (pay PaymentService) RefundAlipay(ctx context.Context, param *Params) (*Result, error) {
+	return pay.refundForeign(ctx, param, PaymentServiceType(Alipay), AlipayCurrencies)
+}
+
+// AuthorizeUPop starts the unionpay payment and returns the url redirecting the customer to unionpay
+func (pay PaymentService) AuthorizeUPop(ctx context.Context, param *Params) (*Result, error) {
+	return pay.authorizeForeign(ctx, param, PaymentServiceType(UPop), UPopCurrencies)
+}
+
+// CompleteUPop verifies the callback of the customer returned from unionpay and returns the payment result
+func (pay PaymentService) CompleteUPop(ctx context.Context, values url.Values) (*Result, error) {
+	return pay.completeCallback(values, PaymentServiceType(UPop))
+}
+
+// RefundUPop refunds the unionpay payment (partially if the amount is given)
+func (pay PaymentService) RefundUPop(ctx context.Context, param *Params) (*Result, error) {
+	return pay.refundForeign(ctx, param, PaymentServiceType(UPop), UPopCurrencies)
+}
+
+// Authorize the redirect payment of the overseas service
+func (pay PaymentService) authorizeForeign(ctx context.Context, param *Params, serviceType PaymentServiceType, currencies []string) (*Result, error) {
+	if err := validateForeignParams(param, currencies); err != nil {
+		return nil, err
+	}
+
+	authorizeParam := *param
+	authorizeParam.Currency = getCurrency(param)
+	authorizeParam.ReturnURL = withOrderID(param.ReturnURL, param.OrderID)
+	result, err := pay.Authorize(ctx, &authorizeParam, serviceType)
+	if err != nil {
+		return nil, err
+	}
+
+	pay.savePendingState(&authorizeParam, serviceType)
+	return result, nil
+}
+
+// Refund the payment of the overseas service
+func (pay PaymentService) refundForeign(ctx context.Context, param *Params, serviceType PaymentServiceType, currencies []string) (*Result, error) {
+	if err := validateOrderID(param); err != nil {
+		return nil, err
+	}
+	if err := validateCurrency(param, currencies, param.Amount != ""); err != nil {
+		return nil, err
+	}
+
+	refundParam := *param
+	refundParam.Currency = getCurrency(param)
+	return pay.Refund(ctx, &refundParam, serviceType)
+}
+
+// Validate the authorize parameters of the overseas service
+func validateForeignParams(param *Params, currencies []string) error {
+	if err := validateOrderID(param); err != nil {
+		return err
+	}
+	if err := validateCurrency(param, currencies, true); err != nil {
+		return err
+	}
+	if param.CommodityName == "" {
+		return &ValidationError{Field: "commodityName", Message: "required"}
+	}
+	if !isValidRedirectURL(param.ReturnURL) {
+		return &ValidationError{Field: "returnUrl", Message: "must be an absolute url"}
+	}
+	return nil
+}
+
+// Validate the currency and the amount in the currency
+func validateCurrency(param *Params, currencies []string, amountRequired bool) error {
+	currency := getCurrency(param)
+	if !containsString(currencies, currency) {
+		return &ValidationError{Field: "currency", Message: "unsupported currency " + currency}
+	}
+	if !amountRequired {
+		return nil
+	}
+	if !isValidCurrencyAmount(param.Amount, currency) {
+		return &ValidationError{Field: "amount", Message: "invalid amount in " + currency}
+	}
+	return nil
+}
+
+// Get the currency of the parameters (JPY by default)
+func getCurrency(param *Params) string {
+	if param.Currency == "" {
+		return Currencies[JPY]
+	}
+	return param.Currency
+}
+
+// Check if the amount is positive and has the decimals allowed in the currency
+func isValidCurrencyAmount(amount string, currency string) bool {
+	if currency == Currencies[JPY] {
+		return isValidAmount(amount)
+	}
+	return decimalAmountPattern.MatchString(amount) && strings.Trim(amount, "0.") != ""
+}
@@ -0,0 +1,44 @@
+package veritrans
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestValidateForeignParams(t *testing.T) {
+	validParam := func() *Params {
+		return &Params{
+			OrderID:       "alipay-order-1",
+			Amount:        "1000",
+			CommodityName: "souvenir",
+			ReturnURL:     "https://example.com/payment/alipay/callback",
+		}
+	}
+	assert.Nil(t, validateForeignParams(validParam(), AlipayCurrencies))
+
+	param := validParam()
+	param.Currency, param.Amount = "CNY", "52.30"
+	assert.Nil(t, validateForeignParams(param, AlipayCurrencies))
+
+	invalidParams := []func(*Params){
+		func(p *Params) { p.OrderID = "" },
+		func(p *Params) { p.Amount = "10.5" },
+		func(p *Params) { p.Currency, p.Amount = "CNY", "1.234" },
+		func(p *Params) { p.Currency, p.Amount = "CNY", "0.00" },
+		func(p *Params) { p.Currency = "USD" },
+		func(p *Params) { p.CommodityName = "" },
+		func(p *Params) { p.ReturnURL = "/return" },
+	}
+	for _, modify := range invalidParams {
+		param := validParam()
+		modify(param)
+		err := validateForeignParams(param, AlipayCurrencies)
+		assert.True(t, IsInvalidParameter(err), "%+v", param)
+	}
+
+	// unionpay accepts only the japanese yen
+	param = validParam()
+	param.Currency, param.Amount = "CNY", "52"
+	assert.True(t, IsInvalidParameter(validateForeignParams(param, UPopCurrencies)))
+}
@@ -0,0 +1,114 @@
+package veritrans
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PaymentOptionType is the enum type of the card payment options
+type PaymentOptionType int32
+
+const (
+	// LumpSum indicates the payment at once
+	LumpSum PaymentOptionType = iota
+	// Installment indicates the payment divided into the installment count
+	Installment
+	// Bonus indicates the payment at once with the next bonus
+	Bonus
+	// Revolving indicates the revolving payment
+	Revolving
+)
+
+// PaymentOptionTypes is a list of the card payment options
+var PaymentOptionTypes = []string{"lumpSum", "installment", "bonus", "revolving"}
+
+// jpoCodes are the JPO codes of the payment options
+var jpoCodes = []string{"10", "61", "21", "80"}
+
+const (
+	// MinInstallmentCount is the minimum count of the installments
+	MinInstallmentCount = 2
+	// MaxInstallmentCount is the maximum count of the installments
+	MaxInstallmentCount = 99
+	// jpoInstallmentPrefix is put before the installment count in the JPO
+	jpoInstallmentPrefix = "61C"
+)
+
+// PaymentOption is the typed card payment option, which is encoded into the JPO
+// InstallmentCount is required only for the installment
+type PaymentOption struct {
+	Type             string `json:"type"`
+	InstallmentCount int    `json:"installmentCount,omitempty"`
+}
+
+// EncodeJPO validates the payment option and encodes it into the JPO (e.g. "10", "61C03")
+func (option PaymentOption) EncodeJPO() (string, error) {
+	optionType := -1
+	for i, name := range PaymentOptionTypes {
+		if name == option.Type {
+			optionType = i
+		}
+	}
+	if optionType < 0 {
+		return "", &ValidationError{
+			Field:   "paymentOption.type",
+			Message: "must be one of " + strings.Join(PaymentOptionTypes, ", "),
+		}
+	}
+
+	if PaymentOptionType(optionType) != Installment {
+		if option.InstallmentCount != 0 {
+			return "", &ValidationError{Field: "paymentOption.installmentCount", Message: "allowed only for the installment"}
+		}
+		return jpoCodes[optionType], nil
+	}
+	if option.InstallmentCount < MinInstallmentCount || option.InstallmentCount > MaxInstallmentCount {
+		return "", &ValidationError{
+			Field:   "paymentOption.installmentCount",
+			Message: fmt.Sprintf("must be between %d and %d", MinInstallmentCount, MaxInstallmentCount),
+		}
+	}
+	return fmt.Sprintf("%s%02d", jpoInstallmentPrefix, option.InstallmentCount), nil
+}
+
+// ParseJPO decodes the JPO into the payment option
+func ParseJPO(jpo string) (*PaymentOption, error) {
+	if strings.HasPrefix(jpo, jpoInstallmentPrefix) {
+		count, err := strconv.Atoi(strings.TrimPrefix(jpo, jpoInstallmentPrefix))
+		if err == nil && len(jpo) == len(jpoInstallmentPrefix)+2 &&
+			count >= MinInstallmentCount && count <= MaxInstallmentCount {
+			return &PaymentOption{Type: PaymentOptionTypes[Installment], InstallmentCount: count}, nil
+		}
+	} else {
+		for i, code := range jpoCodes {
+			if code == jpo && PaymentOptionType(i) != Installment {
+				return &PaymentOption{Type: PaymentOptionTypes[i]}, nil
+			}
+		}
+	}
+	return nil, &ValidationError{Field: "jpo", Message: "unknown payment option " + jpo}
+}
+
+// Encode the payment option of the params into the JPO
+// The raw JPO is still accepted, but it must be a known code and agree with the payment option
+func resolveJPO(param *Params) error {
+	if param.PaymentOption == nil {
+		if param.JPO == "" {
+			return nil
+		}
+		_, err := ParseJPO(param.JPO)
+		return err
+	}
+
+	jpo, err := param.PaymentOption.EncodeJPO()
+	if err != nil {
+		return err
+	}
+	if param.JPO != "" && param.JPO != jpo {
+		return &ValidationError{Field: "jpo", Message: "conflicts with paymentOption"}
+	}
+	param.JPO = jpo
+	param.PaymentOption = nil
+	return nil
+}
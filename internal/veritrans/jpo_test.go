@@ -0,0 +1,62 @@
+package veritrans
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestEncodeJPO(t *testing.T) {
+	testCases := []struct {
+		option PaymentOption
+		jpo    string
+	}{
+		{PaymentOption{Type: "lumpSum"}, "10"},
+		{PaymentOption{Type: "bonus"}, "21"},
+		{PaymentOption{Type: "revolving"}, "80"},
+		{PaymentOption{Type: "installment", InstallmentCount: 3}, "61C03"},
+		{PaymentOption{Type: "installment", InstallmentCount: 24}, "61C24"},
+	}
+	for _, testCase := range testCases {
+		jpo, err := testCase.option.EncodeJPO()
+		assert.Nil(t, err)
+		assert.Equal(t, testCase.jpo, jpo)
+
+		option, err := ParseJPO(jpo)
+		assert.Nil(t, err)
+		assert.Equal(t, testCase.option, *option)
+	}
+
+	invalidOptions := []PaymentOption{
+		{},
+		{Type: "monthly"},
+		{Type: "lumpSum", InstallmentCount: 3},
+		{Type: "installment"},
+		{Type: "installment", InstallmentCount: 1},
+		{Type: "installment", InstallmentCount: 100},
+	}
+	for _, option := range invalidOptions {
+		_, err := option.EncodeJPO()
+		assert.True(t, IsInvalidParameter(err), "%+v", option)
+	}
+
+	for _, jpo := range []string{"", "61", "61C01", "61C3", "61C100", "99"} {
+		_, err := ParseJPO(jpo)
+		assert.True(t, IsInvalidParameter(err), jpo)
+	}
+}
+
+func TestResolveJPO(t *testing.T) {
+	param := &Params{PaymentOption: &PaymentOption{Type: "installment", InstallmentCount: 6}}
+	assert.Nil(t, resolveJPO(param))
+	assert.Equal(t, "61C06", param.JPO)
+	assert.Nil(t, param.PaymentOption)
+
+	// the raw jpo is still accepted when it is known
+	assert.Nil(t, resolveJPO(&Params{}))
+	assert.Nil(t, resolveJPO(&Params{JPO: "10"}))
+	assert.True(t, IsInvalidParameter(resolveJPO(&Params{JPO: "61X"})))
+
+	param = &Params{JPO: "10", PaymentOption: &PaymentOption{Type: "revolving"}}
+	assert.True(t, IsInvalidParameter(resolveJPO(param)))
+}
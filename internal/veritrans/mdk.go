@@ -2,9 +2,10 @@ package veritrans
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
-	"io/ioutil"
+	"io"
 	"net/http"
 	"net/url"
 )
@@ -38,9 +39,11 @@ type ClientCardInfo struct {
 }
 
 // MDKConfig is a configuration of the MDK service
+// HTTPClient is shared by the requests (a default client is used if nil)
 type MDKConfig struct {
-	APIURL   string
-	APIToken string
+	APIURL     string
+	APIToken   string
+	HTTPClient *http.Client
 }
 
 // MDKService handles the several veritrans APIs for MDK payment
@@ -55,7 +58,19 @@ func NewMDKService(config MDKConfig) *MDKService {
 }
 
 // ExecuteCardRequest process the requests
-func (mdk *MDKService) ExecuteCardRequest(cardRequest *CardRequest) (*CardResponse, error) {
+func (mdk *MDKService) ExecuteCardRequest(ctx context.Context, cardRequest *CardRequest) (*CardResponse, error) {
+	ctx, span := startRequestSpan(ctx, mdk.Config.APIURL)
+	cardResponse, err := mdk.executeCardRequest(ctx, cardRequest)
+	if cardResponse != nil {
+		endRequestSpan(span, cardResponse.Code, cardResponse.Status, err)
+	} else {
+		endRequestSpan(span, "", "", err)
+	}
+	return cardResponse, err
+}
+
+// Send the card request to the token api and decode the response
+func (mdk *MDKService) executeCardRequest(ctx context.Context, cardRequest *CardRequest) (*CardResponse, error) {
 	cardReqJSON, err := json.Marshal(cardRequest)
 	if err != nil {
 		return nil, err
@@ -66,21 +81,21 @@ func (mdk *MDKService) ExecuteCardRequest(cardRequest *CardRequest) (*CardRespon
 		return nil, err
 	}
 
-	httpClient := &http.Client{}
 	body := bytes.NewBuffer(cardReqJSON)
-	req, err := http.NewRequest("POST", parsedURL.String(), body)
+	req, err := http.NewRequestWithContext(ctx, "POST", parsedURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 	req.Header.Set("Content-Type", "application/json; charset=utf-8")
 	req.Header.Set("Accept", "application/json")
 
-	res, err := httpClient.Do(req)
+	res, err := doRequest(mdk.Config.HTTPClient, req)
 	if err != nil {
 		return nil, err
 	}
+	defer drainBody(res.Body)
 
-	resBody, err := ioutil.ReadAll(res.Body)
+	resBody, err := io.ReadAll(res.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -94,7 +109,7 @@ func (mdk *MDKService) ExecuteCardRequest(cardRequest *CardRequest) (*CardRespon
 }
 
 // GetCardToken gets a card token
-func (mdk *MDKService) GetCardToken(cardInfo *ClientCardInfo) (string, error) {
+func (mdk *MDKService) GetCardToken(ctx context.Context, cardInfo *ClientCardInfo) (string, error) {
 	if cardInfo == nil {
 		return "", errors.New("no card information")
 	}
@@ -108,7 +123,7 @@ func (mdk *MDKService) GetCardToken(cardInfo *ClientCardInfo) (string, error) {
 		Lang:           "ja",
 	}
 
-	cardResponse, err := mdk.ExecuteCardRequest(&cardRequest)
+	cardResponse, err := mdk.ExecuteCardRequest(ctx, &cardRequest)
 	if err != nil {
 		return "", err
 	}
@@ -116,5 +131,10 @@ func (mdk *MDKService) GetCardToken(cardInfo *ClientCardInfo) (string, error) {
 	if cardResponse.Status == "success" {
 		return cardResponse.Token, nil
 	}
-	return "", errors.New(cardResponse.Message)
+	return "", &APIError{
+		VResultCode: cardResponse.Code,
+		MStatus:     cardResponse.Status,
+		Message:     cardResponse.Message,
+		Kind:        "token",
+	}
 }
@@ -1,6 +1,7 @@
 package veritrans
 
 import (
+	"context"
 	"log"
 	"os"
 	"regexp"
@@ -19,12 +20,13 @@ func init() {
 }
 
 func TestMDK(t *testing.T) {
+	ctx := context.Background()
 	cardService := NewMDKService(MDKConfig{
 		APIURL:   os.Getenv("MDK_API_URL"),
 		APIToken: os.Getenv("MDK_API_TOKEN"),
 	})
 
-	cardToken, err := cardService.GetCardToken(&ClientCardInfo{
+	cardToken, err := cardService.GetCardToken(ctx, &ClientCardInfo{
 		CardNumber:   "4111111111111111",
 		CardExpire:   GetAfterOneMonth(),
 		SecurityCode: "123",
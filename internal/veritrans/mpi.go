@@ -0,0 +1,88 @@
+package veritrans
+
+import (
+	"context"
+	"net/url"
+)
+
+// MPIServiceOption is the enum type of the 3-D secure options
+type MPIServiceOption int32
+
+const (
+	// MPIComplete indicates the "mpi-complete" (authorized only when authenticated)
+	MPIComplete MPIServiceOption = iota
+	// MPICompany indicates the "mpi-company" (authorized when the card company takes the risk)
+	MPICompany
+	// MPIMerchant indicates the "mpi-merchant" (authorized when the card company or the merchant takes the risk)
+	MPIMerchant
+	// MPINone indicates the "mpi-none" (authenticated only, and authorized by the card api after the callback)
+	MPINone
+)
+
+// MPIServiceOptions is a list of the 3-D secure options
+var MPIServiceOptions = []string{"mpi-complete", "mpi-company", "mpi-merchant", "mpi-none"}
+
+// AuthorizeMPI starts the 3-D secure authentication and returns the html redirecting to the ACS
+func (pay PaymentService) AuthorizeMPI(ctx context.Context, param *Params) (*Result, error) {
+	if err := validateMPIParams(param); err != nil {
+		return nil, err
+	}
+
+	result, err := pay.Authorize(ctx, param, PaymentServiceType(MPI))
+	if err != nil {
+		return nil, err
+	}
+
+	pay.savePendingState(param, PaymentServiceType(MPI))
+	return result, nil
+}
+
+// CompleteMPI verifies the 3-D secure callback and returns the authorization result
+// The authorization is done by veritrans except "mpi-none", which is authorized by the card api here
+func (pay PaymentService) CompleteMPI(ctx context.Context, values url.Values) (*Result, error) {
+	state, result, err := pay.getCallbackState(values, PaymentServiceType(MPI))
+	if err != nil {
+		return nil, err
+	}
+	if state.Params.ServiceOptionType != MPIServiceOptions[MPINone] {
+		pay.StateStore.Delete(state.Params.OrderID)
+		return result, nil
+	}
+
+	cardParam := Params{
+		OrderID:       state.Params.OrderID,
+		Amount:        state.Params.Amount,
+		JPO:           state.Params.JPO,
+		PaymentOption: state.Params.PaymentOption,
+		WithCapture:   state.Params.WithCapture,
+		PayNowIDParam: state.Params.PayNowIDParam,
+	}
+	// keep the state until the card is authorized, so the callback can be retried
+	result, err = pay.Authorize(ctx, &cardParam, PaymentServiceType(PayCard))
+	if err != nil {
+		return nil, err
+	}
+	pay.StateStore.Delete(state.Params.OrderID)
+	return result, nil
+}
+
+// Validate the 3-D secure authorize parameters
+func validateMPIParams(param *Params) error {
+	if err := validateOrderID(param); err != nil {
+		return err
+	}
+	if !isValidAmount(param.Amount) {
+		return &ValidationError{Field: "amount", Message: "must be a positive integer"}
+	}
+	if !containsString(MPIServiceOptions, param.ServiceOptionType) {
+		return &ValidationError{Field: "serviceOptionType", Message: "must be one of mpi-complete, mpi-company, mpi-merchant or mpi-none"}
+	}
+	if param.PayNowIDParam == nil || (param.PayNowIDParam.Token == "" &&
+		(param.PayNowIDParam.AccountParam == nil || param.PayNowIDParam.AccountParam.AccountID == "")) {
+		return &ValidationError{Field: "payNowIdParam", Message: "token or account required"}
+	}
+	if !isValidRedirectURL(param.RedirectionURI) {
+		return &ValidationError{Field: "redirectionUri", Message: "must be an absolute url"}
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package veritrans
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestValidateMPIParams(t *testing.T) {
+	validParam := func() *Params {
+		return &Params{
+			OrderID:           "mpi-order-1",
+			Amount:            "1000",
+			ServiceOptionType: MPIServiceOptions[MPIComplete],
+			PayNowIDParam:     &PayNowIDParam{Token: "token"},
+			RedirectionURI:    "https://example.com/payment/mpi/callback",
+		}
+	}
+	assert.Nil(t, validateMPIParams(validParam()))
+
+	param := validParam()
+	param.PayNowIDParam = &PayNowIDParam{AccountParam: &AccountParam{AccountID: "account"}}
+	assert.Nil(t, validateMPIParams(param))
+
+	invalidParams := []func(*Params){
+		func(p *Params) { p.OrderID = "" },
+		func(p *Params) { p.Amount = "" },
+		func(p *Params) { p.ServiceOptionType = "mpi" },
+		func(p *Params) { p.PayNowIDParam = nil },
+		func(p *Params) { p.PayNowIDParam = &PayNowIDParam{AccountParam: &AccountParam{}} },
+		func(p *Params) { p.RedirectionURI = "" },
+		func(p *Params) { p.RedirectionURI = "/payment/mpi/callback" },
+		func(p *Params) { p.RedirectionURI = "ftp://example.com/callback" },
+	}
+	for _, modify := range invalidParams {
+		param := validParam()
+		modify(param)
+		err := validateMPIParams(param)
+		assert.True(t, IsInvalidParameter(err), "%+v", param)
+	}
+}
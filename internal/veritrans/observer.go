@@ -0,0 +1,37 @@
+package veritrans
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RequestObserver is called after every veritrans api request
+// api is the path of the request url and status is the http status code or "error"
+type RequestObserver func(api string, status string, took time.Duration)
+
+type requestObserverKey struct{}
+
+// WithRequestObserver returns a copy of the context which reports the veritrans api requests to the observer
+func WithRequestObserver(ctx context.Context, observer RequestObserver) context.Context {
+	return context.WithValue(ctx, requestObserverKey{}, observer)
+}
+
+// Send the request and report its latency to the observer of the request context
+func doRequest(httpClient *http.Client, req *http.Request) (*http.Response, error) {
+	begin := time.Now()
+	res, err := getHTTPClient(httpClient).Do(req)
+
+	if err == nil {
+		setStatusCode(req.Context(), res.StatusCode)
+	}
+	if observer, ok := req.Context().Value(requestObserverKey{}).(RequestObserver); ok && observer != nil {
+		status := "error"
+		if err == nil {
+			status = strconv.Itoa(res.StatusCode)
+		}
+		observer(req.URL.Path, status, time.Since(begin))
+	}
+	return res, err
+}
@@ -0,0 +1,189 @@
+package veritrans
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// PartialServiceTypes are the services which accept the capture and the refund of a part of the amount
+var PartialServiceTypes = []PaymentServiceType{
+	PaymentServiceType(PayCard),
+	PaymentServiceType(Paypal),
+	PaymentServiceType(Carrier),
+}
+
+// OrderBalance is the amounts of the order calculated from its transaction history
+type OrderBalance struct {
+	Authorized uint64
+	Captured   uint64
+	Refunded   uint64
+
+	// the number of the transactions in the history, to tell the later ones apart
+	transactions int
+}
+
+// Capturable returns the authorized amount which is not captured yet
+func (balance OrderBalance) Capturable() uint64 {
+	if balance.Captured >= balance.Authorized {
+		return 0
+	}
+	return balance.Authorized - balance.Captured
+}
+
+// Refundable returns the captured amount which is not refunded yet
+func (balance OrderBalance) Refundable() uint64 {
+	if balance.Refunded >= balance.Captured {
+		return 0
+	}
+	return balance.Captured - balance.Refunded
+}
+
+// PartialCapture captures a part of the authorized amount
+// The amount is checked against the capturable balance of the order before the request
+func (pay PaymentService) PartialCapture(ctx context.Context, param *Params, serviceType PaymentServiceType) (*Result, error) {
+	amount, err := validatePartialParams(param, serviceType, "partial capture")
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := pay.GetOrderBalance(ctx, param.OrderID, serviceType)
+	if err != nil {
+		return nil, err
+	}
+	if amount > balance.Capturable() {
+		return nil, &ValidationError{
+			Field:   "amount",
+			Message: fmt.Sprintf("exceeds the capturable amount %d", balance.Capturable()),
+		}
+	}
+
+	mode := PaymentManagementMode(MethodCapture)
+	result, err := pay.executeCheckedProcess(ctx, serviceType, mode, param, pay.getOrderCheck(serviceType, mode, param, balance))
+	if err != nil {
+		return nil, err
+	}
+	result.RefundableAmount = strconv.FormatUint(balance.Refundable()+amount, 10)
+	return result, nil
+}
+
+// PartialCancel refunds a part of the captured amount
+// The amount is checked against the refundable balance of the order before the request
+func (pay PaymentService) PartialCancel(ctx context.Context, param *Params, serviceType PaymentServiceType) (*Result, error) {
+	amount, err := validatePartialParams(param, serviceType, "partial cancel")
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := pay.GetOrderBalance(ctx, param.OrderID, serviceType)
+	if err != nil {
+		return nil, err
+	}
+	if amount > balance.Refundable() {
+		return nil, &ValidationError{
+			Field:   "amount",
+			Message: fmt.Sprintf("exceeds the refundable amount %d", balance.Refundable()),
+		}
+	}
+
+	mode := PaymentManagementMode(MethodCancel)
+	result, err := pay.executeCheckedProcess(ctx, serviceType, mode, param, pay.getOrderCheck(serviceType, mode, param, balance))
+	if err != nil {
+		return nil, err
+	}
+	result.RefundableAmount = strconv.FormatUint(balance.Refundable()-amount, 10)
+	return result, nil
+}
+
+// GetOrderBalance searches the order and calculates its authorized, captured and refunded amounts
+func (pay PaymentService) GetOrderBalance(ctx context.Context, orderID string, serviceType PaymentServiceType) (*OrderBalance, error) {
+	result, err := pay.Search(ctx, &Params{
+		ServiceTypeCd: []string{PaymentServiceTypes[serviceType]},
+		NewerFlag:     "true",
+		SearchParam: &SearchParam{
+			Common: OrderParam{
+				OrderID: orderID,
+			},
+		},
+	}, PaymentServiceType(Search))
+	if err != nil {
+		return nil, err
+	}
+
+	if result.OrderInfos != nil {
+		for _, orderInfo := range result.OrderInfos.OrderInfo {
+			if orderInfo.OrderID == orderID {
+				return getOrderBalance(&orderInfo), nil
+			}
+		}
+	}
+	return nil, &ValidationError{Field: "orderId", Message: "order not found"}
+}
+
+// Calculate the balance from the successful transactions in the order they were made
+// A cancel before any capture voids the authorization, and a cancel after the capture refunds it
+func getOrderBalance(orderInfo *OrderInfo) *OrderBalance {
+	balance := &OrderBalance{}
+	if orderInfo.TransactionInfos == nil {
+		return balance
+	}
+	balance.transactions = len(orderInfo.TransactionInfos.TransactionInfo)
+
+	for _, transaction := range orderInfo.TransactionInfos.TransactionInfo {
+		if transaction.MStatus != "success" {
+			continue
+		}
+		amount, err := strconv.ParseUint(transaction.Amount, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		switch transaction.Command {
+		case PaymentManagementModes[MethodAuthorize], PaymentManagementModes[MethodReAuthorize]:
+			balance.Authorized = amount
+			balance.Captured, balance.Refunded = 0, 0
+			if transaction.ProperInfo.ReqWithCapture == "true" {
+				balance.Captured = amount
+			}
+		case PaymentManagementModes[MethodCapture]:
+			balance.Captured += amount
+		case PaymentManagementModes[MethodCancel], PaymentManagementModes[MethodRefund]:
+			if balance.Captured > 0 {
+				balance.Refunded += amount
+			} else if amount >= balance.Authorized {
+				balance.Authorized = 0
+			} else {
+				balance.Authorized -= amount
+			}
+		}
+	}
+	return balance
+}
+
+// Validate the partial capture and cancel parameters, and return the requested amount
+func validatePartialParams(param *Params, serviceType PaymentServiceType, operation string) (uint64, error) {
+	if !isPartialServiceType(serviceType) {
+		return 0, &ValidationError{
+			Field:   "serviceType",
+			Message: fmt.Sprintf("%s is not supported by %s", operation, PaymentServiceTypes[serviceType]),
+		}
+	}
+	if err := validateOrderID(param); err != nil {
+		return 0, err
+	}
+	amount, err := strconv.ParseUint(param.Amount, 10, 64)
+	if err != nil || amount == 0 {
+		return 0, &ValidationError{Field: "amount", Message: "must be a positive integer"}
+	}
+	return amount, nil
+}
+
+// Check if the service accepts the partial capture and cancel
+func isPartialServiceType(serviceType PaymentServiceType) bool {
+	for _, partialServiceType := range PartialServiceTypes {
+		if partialServiceType == serviceType {
+			return true
+		}
+	}
+	return false
+}
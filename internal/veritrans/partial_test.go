@@ -0,0 +1,74 @@
+package veritrans
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestGetOrderBalance(t *testing.T) {
+	transaction := func(command, amount, withCapture string) TransactionInfo {
+		return TransactionInfo{
+			Amount:     amount,
+			Command:    command,
+			MStatus:    "success",
+			ProperInfo: ProperTransactionInfo{ReqWithCapture: withCapture},
+		}
+	}
+	failed := transaction("Capture", "500", "")
+	failed.MStatus = "failure"
+
+	testCases := []struct {
+		transactions []TransactionInfo
+		capturable   uint64
+		refundable   uint64
+	}{
+		{nil, 0, 0},
+		{[]TransactionInfo{transaction("Authorize", "1000", "false")}, 1000, 0},
+		{[]TransactionInfo{transaction("Authorize", "1000", "true")}, 0, 1000},
+		{[]TransactionInfo{
+			transaction("Authorize", "1000", "false"),
+			transaction("Capture", "300", ""),
+			failed,
+			transaction("Capture", "200", ""),
+		}, 500, 500},
+		{[]TransactionInfo{
+			transaction("Authorize", "1000", "true"),
+			transaction("Cancel", "400", ""),
+			transaction("Cancel", "100", ""),
+		}, 0, 500},
+		// the cancel before the capture reduces the authorization
+		{[]TransactionInfo{
+			transaction("Authorize", "1000", "false"),
+			transaction("Cancel", "1000", ""),
+		}, 0, 0},
+	}
+	for _, testCase := range testCases {
+		balance := getOrderBalance(&OrderInfo{
+			TransactionInfos: &TransactionInfos{TransactionInfo: testCase.transactions},
+		})
+		assert.Equal(t, testCase.capturable, balance.Capturable(), "%+v", testCase.transactions)
+		assert.Equal(t, testCase.refundable, balance.Refundable(), "%+v", testCase.transactions)
+	}
+}
+
+func TestValidatePartialParams(t *testing.T) {
+	amount, err := validatePartialParams(&Params{OrderID: "order-1", Amount: "300"}, PaymentServiceType(PayCard), "partial capture")
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(300), amount)
+
+	invalidCases := []struct {
+		param       *Params
+		serviceType PaymentServiceType
+	}{
+		{&Params{OrderID: "order-1", Amount: "300"}, PaymentServiceType(CVS)},
+		{&Params{Amount: "300"}, PaymentServiceType(PayCard)},
+		{&Params{OrderID: "order-1"}, PaymentServiceType(Paypal)},
+		{&Params{OrderID: "order-1", Amount: "0"}, PaymentServiceType(Carrier)},
+		{&Params{OrderID: "order-1", Amount: "1.5"}, PaymentServiceType(PayCard)},
+	}
+	for _, invalidCase := range invalidCases {
+		_, err := validatePartialParams(invalidCase.param, invalidCase.serviceType, "partial capture")
+		assert.True(t, IsInvalidParameter(err), "%+v", invalidCase.param)
+	}
+}
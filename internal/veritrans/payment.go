@@ -1,19 +1,26 @@
 package veritrans
 
 import (
+	"context"
 	"errors"
 	"fmt"
 )
 
 // PaymentService is a service for the payment api
+// StateStore keeps the pending states of the redirect payments until the callback
 type PaymentService struct {
-	Config ConnectionConfig
+	Config     ConnectionConfig
+	StateStore StateStore
 }
 
 // NewPaymentService initializes the PaymentService
 func NewPaymentService(config ConnectionConfig) (*PaymentService, error) {
 	if config.PaymentAPIURL != "" {
-		return &PaymentService{Config: config}, nil
+		stateStore := config.StateStore
+		if stateStore == nil {
+			stateStore = NewMemoryStateStore()
+		}
+		return &PaymentService{Config: config, StateStore: stateStore}, nil
 	}
 	return nil, errors.New("api URL not provided")
 }
@@ -24,6 +31,9 @@ func (pay PaymentService) getConnectionParam(param *Params) (*ConnectionParam, e
 	newParam.TxnVersion = pay.Config.TxnVersion
 	newParam.DummyRequest = pay.Config.DummyRequest
 	newParam.MerchantCCID = pay.Config.MerchantCCID
+	if err := resolveJPO(&newParam); err != nil {
+		return nil, err
+	}
 
 	connectionParam := &ConnectionParam{
 		Params:   newParam,
@@ -37,7 +47,22 @@ func (pay PaymentService) getConnectionParam(param *Params) (*ConnectionParam, e
 }
 
 // Execute Payment
-func (pay PaymentService) executePaymentProcess(serviceType PaymentServiceType, mode PaymentManagementMode, param *Params) (*Result, error) {
+func (pay PaymentService) executePaymentProcess(ctx context.Context, serviceType PaymentServiceType, mode PaymentManagementMode, param *Params) (*Result, error) {
+	// the search is safe to retry, and the others are checked by the order ID before retrying
+	var check idempotencyCheck
+	if mode != PaymentManagementMode(MethodSearch) {
+		check = pay.getOrderCheck(serviceType, mode, param, nil)
+	}
+	return pay.executeCheckedProcess(ctx, serviceType, mode, param, check)
+}
+
+// Execute Payment with the check called before retrying it
+func (pay PaymentService) executeCheckedProcess(ctx context.Context, serviceType PaymentServiceType, mode PaymentManagementMode, param *Params,
+	check idempotencyCheck) (*Result, error) {
+	if mode != PaymentManagementMode(MethodSearch) && !pay.IsEnabled(serviceType) {
+		return nil, &ValidationError{Field: "serviceType", Message: serviceType.String() + " is not enabled"}
+	}
+
 	connectionParam, err := pay.getConnectionParam(param)
 	if err != nil {
 		return nil, err
@@ -47,8 +72,10 @@ func (pay PaymentService) executePaymentProcess(serviceType PaymentServiceType,
 	if mode == PaymentManagementMode(MethodSearch) {
 		apiURL = pay.Config.SearchAPIURL
 	}
-	paymentRes, err := ProcessRequest(
-		fmt.Sprintf("%s/%s/%s", apiURL, PaymentManagementModes[mode], PaymentServiceTypes[serviceType]), connectionParam)
+	requestKind := fmt.Sprintf("%s/%s", PaymentManagementModes[mode], PaymentServiceTypes[serviceType])
+
+	paymentRes, err := pay.Config.processRequestWithRetry(
+		ctx, fmt.Sprintf("%s/%s", apiURL, requestKind), connectionParam, requestKind, check)
 	if err != nil {
 		return nil, err
 	}
@@ -56,38 +83,134 @@ func (pay PaymentService) executePaymentProcess(serviceType PaymentServiceType,
 	if paymentRes.Result.MStatus == "success" {
 		return &paymentRes.Result, nil
 	}
-	return nil, errors.New(paymentRes.Result.MErrorMsg)
+	return nil, newAPIError(&paymentRes.Result, requestKind)
+}
+
+// IsEnabled checks if the merchant accepts the payment service
+func (pay PaymentService) IsEnabled(serviceType PaymentServiceType) bool {
+	return len(pay.Config.EnabledServiceTypes) == 0 || containsString(pay.Config.EnabledServiceTypes, serviceType.String())
+}
+
+// Get the idempotency check which searches the order to confirm whether the request was applied
+// If the balance before the request is known, only the transactions made after it with the requested amount are matched,
+// so that an earlier partial capture or cancel of the order is not taken for the retried one
+func (pay PaymentService) getOrderCheck(serviceType PaymentServiceType, mode PaymentManagementMode, param *Params,
+	known *OrderBalance) idempotencyCheck {
+	return func(ctx context.Context) (*ConnectionResponse, error) {
+		if param.OrderID == "" {
+			return nil, errors.New("order ID not provided")
+		}
+
+		searchParam := &Params{
+			ContainDummyFlag: pay.Config.DummyRequest,
+			ServiceTypeCd:    []string{PaymentServiceTypes[serviceType]},
+			NewerFlag:        "true",
+			SearchParam: &SearchParam{
+				Common: OrderParam{
+					OrderID: param.OrderID,
+				},
+			},
+		}
+		connectionParam, err := pay.getConnectionParam(searchParam)
+		if err != nil {
+			return nil, err
+		}
+
+		searchRes, err := ProcessRequest(ctx, pay.Config.HTTPClient, fmt.Sprintf("%s/%s/%s",
+			pay.Config.SearchAPIURL, PaymentManagementModes[MethodSearch], PaymentServiceTypes[Search]), connectionParam)
+		if err != nil {
+			return nil, err
+		}
+		if searchRes.Result.MStatus != "success" {
+			return nil, newAPIError(&searchRes.Result, "Search/search")
+		}
+		if searchRes.Result.OrderInfos == nil {
+			return nil, nil
+		}
+
+		command := PaymentManagementModes[mode]
+		for _, orderInfo := range searchRes.Result.OrderInfos.OrderInfo {
+			if orderInfo.OrderID != param.OrderID || orderInfo.TransactionInfos == nil {
+				continue
+			}
+			if known == nil && orderInfo.LastSuccessTxnType != command {
+				continue
+			}
+
+			transactions := orderInfo.TransactionInfos.TransactionInfo
+			first := 0
+			if known != nil {
+				first = known.transactions
+			}
+			for i := len(transactions) - 1; i >= first; i-- {
+				if known != nil && transactions[i].Amount != param.Amount {
+					continue
+				}
+				if transactions[i].Command == command && transactions[i].MStatus == "success" {
+					return &ConnectionResponse{
+						Result: Result{
+							VResultCode:        transactions[i].VResultCode,
+							MStatus:            transactions[i].MStatus,
+							OrderID:            orderInfo.OrderID,
+							ServiceType:        orderInfo.ServiceTypeCd,
+							CustTxn:            transactions[i].TxnID,
+							ReqAmount:          transactions[i].Amount,
+							CenterResponseDate: transactions[i].TxnDateTime,
+						},
+					}, nil
+				}
+			}
+		}
+		return nil, nil
+	}
 }
 
 // Authorize function
-func (pay PaymentService) Authorize(param *Params, serviceType PaymentServiceType) (*Result, error) {
+func (pay PaymentService) Authorize(ctx context.Context, param *Params, serviceType PaymentServiceType) (*Result, error) {
 	return pay.executePaymentProcess(
+		ctx,
 		serviceType,
 		PaymentManagementMode(MethodAuthorize),
 		param)
 }
 
 // Capture function
-func (pay PaymentService) Capture(param *Params, serviceType PaymentServiceType) (*Result, error) {
+func (pay PaymentService) Capture(ctx context.Context, param *Params, serviceType PaymentServiceType) (*Result, error) {
 	return pay.executePaymentProcess(
+		ctx,
 		serviceType,
 		PaymentManagementMode(MethodCapture),
 		param)
 }
 
 // Cancel function
-func (pay PaymentService) Cancel(param *Params, serviceType PaymentServiceType) (*Result, error) {
+func (pay PaymentService) Cancel(ctx context.Context, param *Params, serviceType PaymentServiceType) (*Result, error) {
 	return pay.executePaymentProcess(
+		ctx,
 		serviceType,
 		PaymentManagementMode(MethodCancel),
 		param)
 }
 
+// Refund function
+func (pay PaymentService) Refund(ctx context.Context, param *Params, serviceType PaymentServiceType) (*Result, error) {
+	return pay.executePaymentProcess(
+		ctx,
+		serviceType,
+		PaymentManagementMode(MethodRefund),
+		param)
+}
+
 // Search function
-func (pay PaymentService) Search(param *Params, serviceType PaymentServiceType) (*Result, error) {
+func (pay PaymentService) Search(ctx context.Context, param *Params, serviceType PaymentServiceType) (*Result, error) {
+	searchParam := *param
+	if searchParam.ContainDummyFlag == "" {
+		searchParam.ContainDummyFlag = pay.Config.DummyRequest
+	}
 	return pay.executePaymentProcess(
+		ctx,
 		serviceType,
 		PaymentManagementMode(MethodSearch),
-		param,
+		&searchParam,
 	)
 }
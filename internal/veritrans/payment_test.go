@@ -1,6 +1,7 @@
 package veritrans
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -35,26 +36,27 @@ func init() {
 }
 
 func TestPayment(t *testing.T) {
+	ctx := context.Background()
 	testAccountID := "PAYMENT_ACCOUNT_01"
 	accountParam := &AccountParam{
 		AccountID: testAccountID,
 	}
 
 	// Create Account
-	account, err := accountService.GetAccount(accountParam)
+	account, err := accountService.GetAccount(ctx, accountParam)
 	if err == nil {
 		// Assert if the account exists
 		assert.Equal(t, testAccountID, account.AccountID)
 	} else {
-		assert.Equal(t, "未登録の会員です。", err.Error())
-		account, err := accountService.CreateAccount(accountParam)
+		assert.True(t, IsAccountNotFound(err))
+		account, err := accountService.CreateAccount(ctx, accountParam)
 
 		// Create if the account doesn't exist
 		assert.Nil(t, err)
 		assert.Equal(t, testAccountID, account.AccountID)
 	}
 
-	account, err = accountService.GetCard(accountParam)
+	account, err = accountService.GetCard(ctx, accountParam)
 	assert.Nil(t, err)
 	if len(account.CardInfo) == 0 {
 		// Add Card
@@ -65,7 +67,7 @@ func TestPayment(t *testing.T) {
 			CardExpire:  expiredAt,
 			DefaultCard: "1",
 		}
-		_, err = accountService.CreateCard(accountParam)
+		_, err = accountService.CreateCard(ctx, accountParam)
 		assert.Nil(t, err)
 	}
 
@@ -86,7 +88,7 @@ func TestPayment(t *testing.T) {
 			},
 		},
 	}
-	_, err = paymentService.Authorize(&authorizeParam, PaymentServiceType(PayCard))
+	_, err = paymentService.Authorize(ctx, &authorizeParam, PaymentServiceType(PayCard))
 	assert.Nil(t, err)
 
 	searchParam := Params{
@@ -99,7 +101,7 @@ func TestPayment(t *testing.T) {
 			},
 		},
 	}
-	result, err := paymentService.Search(&searchParam, PaymentServiceType(Search))
+	result, err := paymentService.Search(ctx, &searchParam, PaymentServiceType(Search))
 	assert.Nil(t, err)
 	assert.Equal(t, "success", result.MStatus)
 	assert.NotNil(t, result.OrderInfos)
@@ -121,10 +123,10 @@ func TestPayment(t *testing.T) {
 		OrderID: testOrderID,
 		Amount:  payAmount,
 	}
-	_, err = paymentService.Cancel(&cancelParam, PaymentServiceType(PayCard))
+	_, err = paymentService.Cancel(ctx, &cancelParam, PaymentServiceType(PayCard))
 	assert.Nil(t, err)
 
-	result, err = paymentService.Search(&searchParam, PaymentServiceType(Search))
+	result, err = paymentService.Search(ctx, &searchParam, PaymentServiceType(Search))
 	assert.Nil(t, err)
 	assert.Equal(t, "success", result.MStatus)
 	assert.NotNil(t, result.OrderInfos)
@@ -147,11 +149,11 @@ func TestPayment(t *testing.T) {
 
 	authorizeParam.WithCapture = "true"
 	authorizeParam.OrderID = testOrderID
-	_, err = paymentService.Authorize(&authorizeParam, PaymentServiceType(PayCard))
+	_, err = paymentService.Authorize(ctx, &authorizeParam, PaymentServiceType(PayCard))
 	assert.Nil(t, err)
 
 	searchParam.SearchParam.Common.OrderID = testOrderID
-	result, err = paymentService.Search(&searchParam, PaymentServiceType(Search))
+	result, err = paymentService.Search(ctx, &searchParam, PaymentServiceType(Search))
 	assert.Nil(t, err)
 	assert.Equal(t, "success", result.MStatus)
 	assert.NotNil(t, result.OrderInfos)
@@ -170,6 +172,7 @@ func TestPayment(t *testing.T) {
 }
 
 func findNewOrderID() (string, error) {
+	ctx := context.Background()
 	testOrderID := ""
 	for {
 		randomID := GetRandomID(8)
@@ -184,7 +187,7 @@ func findNewOrderID() (string, error) {
 				},
 			},
 		}
-		result, err := paymentService.Search(&searchParam, PaymentServiceType(Search))
+		result, err := paymentService.Search(ctx, &searchParam, PaymentServiceType(Search))
 		if err != nil {
 			return "", err
 		}
@@ -194,3 +197,30 @@ func findNewOrderID() (string, error) {
 	}
 	return testOrderID, nil
 }
+
+func TestGetPaymentServiceType(t *testing.T) {
+	serviceType, err := GetPaymentServiceType("")
+	assert.Nil(t, err)
+	assert.Equal(t, PaymentServiceType(PayCard), serviceType)
+
+	serviceType, err = GetPaymentServiceType("carrier")
+	assert.Nil(t, err)
+	assert.Equal(t, PaymentServiceType(Carrier), serviceType)
+
+	for _, name := range []string{"search", "unknown"} {
+		_, err = GetPaymentServiceType(name)
+		assert.True(t, IsInvalidParameter(err), name)
+	}
+}
+
+func TestEnabledServiceTypes(t *testing.T) {
+	service, err := NewPaymentService(ConnectionConfig{
+		PaymentAPIURL:       "http://127.0.0.1:1",
+		EnabledServiceTypes: []string{"card"},
+	})
+	assert.Nil(t, err)
+
+	_, err = service.Cancel(context.Background(), &Params{OrderID: "order-1"}, PaymentServiceType(CVS))
+	assert.True(t, IsInvalidParameter(err))
+	assert.Contains(t, err.Error(), "cvs is not enabled")
+}
@@ -0,0 +1,152 @@
+package veritrans
+
+import (
+	"context"
+	"net/url"
+)
+
+// PaypalAction is the enum type of the paypal payment steps
+type PaypalAction int32
+
+const (
+	// PaypalSet indicates the "set" (start the payment and get the login url)
+	PaypalSet PaypalAction = iota
+	// PaypalDo indicates the "do" (authorize the payment approved by the customer)
+	PaypalDo
+)
+
+// PaypalActions is a list of the paypal payment steps
+var PaypalActions = []string{"set", "do"}
+
+// PaypalCancelStatus is the mstatus of the result when the customer cancelled the payment on paypal
+const PaypalCancelStatus = "cancel"
+
+// paypalCancelKey marks the callback to the cancel url
+const paypalCancelKey = "cancel"
+
+// AuthorizePaypal starts the paypal payment and returns the login url redirecting the customer to paypal
+// The signed order ID is added to the return and cancel urls, so the callback can be verified and find the pending payment
+func (pay PaymentService) AuthorizePaypal(ctx context.Context, param *Params) (*Result, error) {
+	if err := validatePaypalParams(param); err != nil {
+		return nil, err
+	}
+
+	setParam := *param
+	setParam.Action = PaypalActions[PaypalSet]
+	setParam.ReturnURL = pay.withSignedQuery(param.ReturnURL, url.Values{"orderId": {param.OrderID}})
+	setParam.CancelURL = pay.withSignedQuery(param.CancelURL, url.Values{"orderId": {param.OrderID}, paypalCancelKey: {"true"}})
+	result, err := pay.Authorize(ctx, &setParam, PaymentServiceType(Paypal))
+	if err != nil {
+		return nil, err
+	}
+
+	// keep the paypal token to check it against the callback
+	stateParam := *param
+	stateParam.Token = getPaypalToken(result.LoginURL)
+	pay.savePendingState(&stateParam, PaymentServiceType(Paypal))
+	return result, nil
+}
+
+// CompletePaypal verifies the callback of the customer redirected back from paypal
+// The approved payment (with the token and PayerID) is authorized, and the cancelled one returns the PaypalCancelStatus
+// The pending payment is kept until it is authorized or cancelled, so the failed callback can be retried
+func (pay PaymentService) CompletePaypal(ctx context.Context, values url.Values) (*Result, error) {
+	if err := pay.verifySignedQuery(values, "orderId", paypalCancelKey); err != nil {
+		return nil, err
+	}
+
+	orderID := values.Get("orderId")
+	state, err := pay.getPendingState(orderID, PaymentServiceType(Paypal))
+	if err != nil {
+		return nil, err
+	}
+	if state.Params.Token != "" && values.Get("token") != state.Params.Token {
+		return nil, &ValidationError{Field: "token", Message: "does not match the pending paypal payment"}
+	}
+
+	if values.Get(paypalCancelKey) == "true" {
+		pay.StateStore.Delete(orderID)
+		return &Result{
+			MStatus:     PaypalCancelStatus,
+			OrderID:     orderID,
+			ServiceType: PaymentServiceTypes[Paypal],
+		}, nil
+	}
+
+	payerID := values.Get("PayerID")
+	if payerID == "" {
+		return nil, &ValidationError{Field: "PayerID", Message: "required"}
+	}
+
+	doParam := Params{
+		OrderID:     orderID,
+		Amount:      state.Params.Amount,
+		WithCapture: state.Params.WithCapture,
+		Action:      PaypalActions[PaypalDo],
+		Token:       values.Get("token"),
+		PayerID:     payerID,
+	}
+	result, err := pay.Authorize(ctx, &doParam, PaymentServiceType(Paypal))
+	if err != nil {
+		return nil, err
+	}
+	pay.StateStore.Delete(orderID)
+	return result, nil
+}
+
+// CapturePaypal captures the authorized paypal payment
+func (pay PaymentService) CapturePaypal(ctx context.Context, param *Params) (*Result, error) {
+	if err := validateOrderID(param); err != nil {
+		return nil, err
+	}
+	if param.Amount != "" && !isValidAmount(param.Amount) {
+		return nil, &ValidationError{Field: "amount", Message: "must be a positive integer"}
+	}
+	return pay.Capture(ctx, param, PaymentServiceType(Paypal))
+}
+
+// CancelPaypal cancels the paypal payment
+func (pay PaymentService) CancelPaypal(ctx context.Context, param *Params) (*Result, error) {
+	if err := validateOrderID(param); err != nil {
+		return nil, err
+	}
+	return pay.Cancel(ctx, param, PaymentServiceType(Paypal))
+}
+
+// Validate the paypal authorize parameters
+func validatePaypalParams(param *Params) error {
+	if err := validateOrderID(param); err != nil {
+		return err
+	}
+	if !isValidAmount(param.Amount) {
+		return &ValidationError{Field: "amount", Message: "must be a positive integer"}
+	}
+	if !isValidRedirectURL(param.ReturnURL) {
+		return &ValidationError{Field: "returnUrl", Message: "must be an absolute url"}
+	}
+	if !isValidRedirectURL(param.CancelURL) {
+		return &ValidationError{Field: "cancelUrl", Message: "must be an absolute url"}
+	}
+	return nil
+}
+
+// Add the order ID to the query of the url
+func withOrderID(rawURL string, orderID string) string {
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	query := parsedURL.Query()
+	query.Set("orderId", orderID)
+	parsedURL.RawQuery = query.Encode()
+	return parsedURL.String()
+}
+
+// Get the paypal token from the query of the login url
+func getPaypalToken(loginURL string) string {
+	parsedURL, err := url.Parse(loginURL)
+	if err != nil {
+		return ""
+	}
+	return parsedURL.Query().Get("token")
+}
@@ -0,0 +1,42 @@
+package veritrans
+
+import (
+	"net/url"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestValidatePaypalParams(t *testing.T) {
+	validParam := func() *Params {
+		return &Params{
+			OrderID:   "paypal-order-1",
+			Amount:    "1000",
+			ReturnURL: "https://example.com/payment/paypal/callback",
+			CancelURL: "https://example.com/payment/paypal/callback",
+		}
+	}
+	assert.Nil(t, validatePaypalParams(validParam()))
+
+	invalidParams := []func(*Params){
+		func(p *Params) { p.OrderID = "" },
+		func(p *Params) { p.Amount = "1.5" },
+		func(p *Params) { p.ReturnURL = "" },
+		func(p *Params) { p.ReturnURL = "example.com/return" },
+		func(p *Params) { p.CancelURL = "javascript:alert(1)" },
+	}
+	for _, modify := range invalidParams {
+		param := validParam()
+		modify(param)
+		err := validatePaypalParams(param)
+		assert.True(t, IsInvalidParameter(err), "%+v", param)
+	}
+}
+
+func TestWithOrderID(t *testing.T) {
+	parsedURL, err := url.Parse(withOrderID("https://example.com/callback?lang=ja", "paypal-order-1"))
+	assert.Nil(t, err)
+	assert.Equal(t, "/callback", parsedURL.Path)
+	assert.Equal(t, "ja", parsedURL.Query().Get("lang"))
+	assert.Equal(t, "paypal-order-1", parsedURL.Query().Get("orderId"))
+}
@@ -0,0 +1,49 @@
+package veritrans
+
+import (
+	"context"
+	"fmt"
+)
+
+// ReAuthorize authorizes the new amount with the card of the original order under the new order ID
+// The original authorization is cancelled after the success when cancelOriginal is set
+func (pay PaymentService) ReAuthorize(ctx context.Context, param *Params, cancelOriginal bool) (*Result, error) {
+	if err := validateReAuthorizeParams(param); err != nil {
+		return nil, err
+	}
+
+	result, err := pay.executePaymentProcess(
+		ctx,
+		PaymentServiceType(PayCard),
+		PaymentManagementMode(MethodReAuthorize),
+		param)
+	if err != nil || !cancelOriginal {
+		return result, err
+	}
+
+	if _, err := pay.Cancel(ctx, &Params{OrderID: param.OriginalOrderID}, PaymentServiceType(PayCard)); err != nil {
+		return nil, fmt.Errorf("re-authorized as %s but the original order %s was not cancelled: %w",
+			param.OrderID, param.OriginalOrderID, err)
+	}
+	return result, nil
+}
+
+// Validate the re-authorize parameters
+func validateReAuthorizeParams(param *Params) error {
+	if err := validateOrderID(param); err != nil {
+		return err
+	}
+	if param.OriginalOrderID == "" {
+		return &ValidationError{Field: "originalOrderId", Message: "required"}
+	}
+	if param.OriginalOrderID == param.OrderID {
+		return &ValidationError{Field: "orderId", Message: "must differ from originalOrderId"}
+	}
+	if !isValidAmount(param.Amount) {
+		return &ValidationError{Field: "amount", Message: "must be a positive integer"}
+	}
+	if param.PayNowIDParam != nil {
+		return &ValidationError{Field: "payNowIdParam", Message: "the card of the original order is used"}
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package veritrans
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestValidateReAuthorizeParams(t *testing.T) {
+	validParam := func() *Params {
+		return &Params{
+			OrderID:         "reauth-order-2",
+			OriginalOrderID: "reauth-order-1",
+			Amount:          "1500",
+		}
+	}
+	assert.Nil(t, validateReAuthorizeParams(validParam()))
+
+	invalidParams := []func(*Params){
+		func(p *Params) { p.OrderID = "" },
+		func(p *Params) { p.OriginalOrderID = "" },
+		func(p *Params) { p.OriginalOrderID = p.OrderID },
+		func(p *Params) { p.Amount = "0" },
+		func(p *Params) { p.PayNowIDParam = &PayNowIDParam{Token: "test-token"} },
+	}
+	for _, modify := range invalidParams {
+		param := validParam()
+		modify(param)
+		err := validateReAuthorizeParams(param)
+		assert.True(t, IsInvalidParameter(err), "%+v", param)
+	}
+}
@@ -0,0 +1,136 @@
+package veritrans
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// RetryPolicy is a configuration of retrying the veritrans requests
+// MaxRetries is the number of retries after the first attempt (0 disables retrying)
+// BaseDelay is the delay before the first retry, doubled on every retry up to MaxDelay
+// RetryableCodes is a list of the vResultCode prefixes regarded as temporary failures
+type RetryPolicy struct {
+	MaxRetries     int
+	BaseDelay      time.Duration
+	MaxDelay       time.Duration
+	RetryableCodes []string
+}
+
+// DefaultRetryPolicy returns the default retry policy
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries:     2,
+		BaseDelay:      200 * time.Millisecond,
+		MaxDelay:       2 * time.Second,
+		RetryableCodes: []string{"NE01", "NE02"},
+	}
+}
+
+// Check if the failed result is a temporary failure
+func (policy RetryPolicy) isRetryableResult(result *Result) bool {
+	if result.MStatus == "success" {
+		return false
+	}
+	for _, code := range policy.RetryableCodes {
+		if code != "" && strings.HasPrefix(result.VResultCode, code) {
+			return true
+		}
+	}
+	return false
+}
+
+// Get the delay before the retry with the exponential backoff and jitter
+func (policy RetryPolicy) getDelay(attempt int) time.Duration {
+	delay := policy.BaseDelay
+	for i := 0; i < attempt && delay < policy.MaxDelay; i++ {
+		delay *= 2
+	}
+	if policy.MaxDelay > 0 && delay > policy.MaxDelay {
+		delay = policy.MaxDelay
+	}
+	if delay <= 0 {
+		return 0
+	}
+	// equal jitter keeps at least half of the delay
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+// Check if the request error is a network error or a 5xx response
+func isRetryableError(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode >= 500
+	}
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
+
+// idempotencyCheck is called before retrying a request which is not safe to repeat.
+// It returns the response of the previous attempt if the attempt was applied by veritrans,
+// and an error if it can not be confirmed.
+type idempotencyCheck func(ctx context.Context) (*ConnectionResponse, error)
+
+// Process the request and retry it on the temporary failures
+func (config ConnectionConfig) processRequestWithRetry(ctx context.Context, requestURL string, connectionParam *ConnectionParam,
+	requestKind string, check idempotencyCheck) (*ConnectionResponse, error) {
+	policy := config.RetryPolicy
+	for attempt := 0; ; attempt++ {
+		res, err := ProcessRequest(ctx, config.HTTPClient, requestURL, connectionParam)
+
+		var retryable bool
+		if err != nil {
+			retryable = isRetryableError(ctx, err)
+		} else {
+			retryable = policy.isRetryableResult(&res.Result)
+		}
+		if !retryable || attempt >= policy.MaxRetries {
+			return res, err
+		}
+
+		delay := policy.getDelay(attempt)
+		if config.Logger != nil {
+			reason := interface{}(err)
+			if err == nil {
+				reason = res.Result.VResultCode
+			}
+			config.Logger.Log(
+				"kind", requestKind,
+				"retry", attempt+1,
+				"maxRetries", policy.MaxRetries,
+				"delay", delay,
+				"reason", reason,
+			)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+
+		if check != nil {
+			checkedRes, checkErr := check(ctx)
+			if checkErr != nil {
+				// the previous attempt is not repeated unless it is confirmed as not applied
+				return res, err
+			}
+			if checkedRes != nil {
+				if config.Logger != nil {
+					config.Logger.Log(
+						"kind", requestKind,
+						"retry", attempt+1,
+						"idempotency", "already applied",
+					)
+				}
+				return checkedRes, nil
+			}
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package veritrans
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func getRetryTestConfig(serverURL string) ConnectionConfig {
+	return ConnectionConfig{
+		MerchantCCID:     "test",
+		MerchantPassword: "test",
+		AccountAPIURL:    serverURL + "/account",
+		PaymentAPIURL:    serverURL + "/payment",
+		SearchAPIURL:     serverURL + "/search",
+		TxnVersion:       "2.0.0",
+		DummyRequest:     "1",
+		RetryPolicy: RetryPolicy{
+			MaxRetries:     2,
+			BaseDelay:      time.Millisecond,
+			MaxDelay:       5 * time.Millisecond,
+			RetryableCodes: []string{"NE01"},
+		},
+	}
+}
+
+func writeResult(w http.ResponseWriter, res ConnectionResponse) {
+	json.NewEncoder(w).Encode(res)
+}
+
+func TestRetrySearch(t *testing.T) {
+	var count int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch atomic.AddInt32(&count, 1) {
+		case 1:
+			w.WriteHeader(http.StatusBadGateway)
+		case 2:
+			writeResult(w, ConnectionResponse{Result: Result{MStatus: "failure", VResultCode: "NE01000000000000"}})
+		default:
+			writeResult(w, ConnectionResponse{Result: Result{MStatus: "success", VResultCode: "N001000000000000"}})
+		}
+	}))
+	defer server.Close()
+
+	payService, err := NewPaymentService(getRetryTestConfig(server.URL))
+	assert.Nil(t, err)
+
+	result, err := payService.Search(context.Background(), &Params{}, PaymentServiceType(Search))
+	assert.Nil(t, err)
+	assert.Equal(t, "success", result.MStatus)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&count))
+}
+
+func TestRetryExhausted(t *testing.T) {
+	var count int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&count, 1)
+		writeResult(w, ConnectionResponse{Result: Result{MStatus: "failure", VResultCode: "NE01000000000000"}})
+	}))
+	defer server.Close()
+
+	payService, err := NewPaymentService(getRetryTestConfig(server.URL))
+	assert.Nil(t, err)
+
+	_, err = payService.Search(context.Background(), &Params{}, PaymentServiceType(Search))
+	assert.True(t, IsRetryable(err))
+	assert.Equal(t, int32(3), atomic.LoadInt32(&count))
+}
+
+func TestRetryAuthorizeApplied(t *testing.T) {
+	var authorizeCount, searchCount int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/payment/Authorize/card":
+			atomic.AddInt32(&authorizeCount, 1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case "/search/Search/search":
+			atomic.AddInt32(&searchCount, 1)
+			writeResult(w, ConnectionResponse{Result: Result{
+				MStatus: "success",
+				OrderInfos: &OrderInfos{OrderInfo: []OrderInfo{{
+					OrderID:            "order-1",
+					ServiceTypeCd:      "card",
+					LastSuccessTxnType: "Authorize",
+					TransactionInfos: &TransactionInfos{TransactionInfo: []TransactionInfo{{
+						Amount:      "100",
+						Command:     "Authorize",
+						MStatus:     "success",
+						TxnID:       "txn-1",
+						VResultCode: "A001000000000000",
+					}}},
+				}}},
+			}})
+		}
+	}))
+	defer server.Close()
+
+	payService, err := NewPaymentService(getRetryTestConfig(server.URL))
+	assert.Nil(t, err)
+
+	result, err := payService.Authorize(context.Background(), &Params{OrderID: "order-1", Amount: "100"}, PaymentServiceType(PayCard))
+	assert.Nil(t, err)
+	assert.Equal(t, "txn-1", result.CustTxn)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&authorizeCount))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&searchCount))
+}
+
+func TestRetryPartialCapture(t *testing.T) {
+	history := []TransactionInfo{
+		{Amount: "1000", Command: "Authorize", MStatus: "success", TxnID: "txn-1"},
+		{Amount: "300", Command: "Capture", MStatus: "success", TxnID: "txn-2"},
+	}
+
+	for _, applied := range []bool{false, true} {
+		var captureCount int32
+		transactions := append([]TransactionInfo{}, history...)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/payment/Capture/card":
+				// the first attempt fails, after being applied by veritrans or not
+				if atomic.AddInt32(&captureCount, 1) == 1 {
+					if applied {
+						transactions = append(transactions, TransactionInfo{
+							Amount: "200", Command: "Capture", MStatus: "success", TxnID: "txn-3",
+						})
+					}
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				writeResult(w, ConnectionResponse{Result: Result{MStatus: "success", CustTxn: "txn-4"}})
+			case "/search/Search/search":
+				writeResult(w, ConnectionResponse{Result: Result{
+					MStatus: "success",
+					OrderInfos: &OrderInfos{OrderInfo: []OrderInfo{{
+						OrderID:            "order-1",
+						ServiceTypeCd:      "card",
+						LastSuccessTxnType: "Capture",
+						TransactionInfos:   &TransactionInfos{TransactionInfo: transactions},
+					}}},
+				}})
+			}
+		}))
+
+		payService, err := NewPaymentService(getRetryTestConfig(server.URL))
+		assert.Nil(t, err)
+
+		// the earlier capture of 300 is not taken for the retried capture of 200
+		result, err := payService.PartialCapture(context.Background(), &Params{OrderID: "order-1", Amount: "200"}, PaymentServiceType(PayCard))
+		server.Close()
+		assert.Nil(t, err)
+		assert.Equal(t, "500", result.RefundableAmount)
+		if applied {
+			assert.Equal(t, "txn-3", result.CustTxn)
+			assert.Equal(t, int32(1), atomic.LoadInt32(&captureCount))
+		} else {
+			assert.Equal(t, "txn-4", result.CustTxn)
+			assert.Equal(t, int32(2), atomic.LoadInt32(&captureCount))
+		}
+	}
+}
+
+func TestNoRetryAccountUpdate(t *testing.T) {
+	var count int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&count, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	accService := NewAccountService(getRetryTestConfig(server.URL))
+	_, err := accService.UpdateAccount(context.Background(), &AccountParam{AccountID: "account-1"})
+	assert.NotNil(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&count))
+}
@@ -0,0 +1,58 @@
+package veritrans
+
+import (
+	"context"
+	"strconv"
+)
+
+// AuthorizeSaison authorizes the payment paid partially by the saison permanent points and the rest by the card
+func (pay PaymentService) AuthorizeSaison(ctx context.Context, param *Params) (*Result, error) {
+	if err := validateSaisonParams(param); err != nil {
+		return nil, err
+	}
+	return pay.Authorize(ctx, param, PaymentServiceType(Saison))
+}
+
+// CaptureSaison captures the authorized saison payment
+func (pay PaymentService) CaptureSaison(ctx context.Context, param *Params) (*Result, error) {
+	if err := validateOrderID(param); err != nil {
+		return nil, err
+	}
+	return pay.Capture(ctx, param, PaymentServiceType(Saison))
+}
+
+// CancelSaison cancels the saison payment, and the used points are given back
+func (pay PaymentService) CancelSaison(ctx context.Context, param *Params) (*Result, error) {
+	if err := validateOrderID(param); err != nil {
+		return nil, err
+	}
+	return pay.Cancel(ctx, param, PaymentServiceType(Saison))
+}
+
+// Validate the saison authorize parameters
+// The amount must be the sum of the card amount and the point amount
+func validateSaisonParams(param *Params) error {
+	if err := validateOrderID(param); err != nil {
+		return err
+	}
+	amount, err := strconv.ParseUint(param.Amount, 10, 64)
+	if err != nil || amount == 0 {
+		return &ValidationError{Field: "amount", Message: "must be a positive integer"}
+	}
+	pointAmount, err := strconv.ParseUint(param.PointAmount, 10, 64)
+	if err != nil || pointAmount == 0 {
+		return &ValidationError{Field: "pointAmount", Message: "must be a positive integer"}
+	}
+	cardAmount, err := strconv.ParseUint(param.CardAmount, 10, 64)
+	if err != nil {
+		return &ValidationError{Field: "cardAmount", Message: "must be a non-negative integer"}
+	}
+	if cardAmount+pointAmount != amount {
+		return &ValidationError{Field: "amount", Message: "must be the sum of cardAmount and pointAmount"}
+	}
+	if param.PayNowIDParam == nil || (param.PayNowIDParam.Token == "" &&
+		(param.PayNowIDParam.AccountParam == nil || param.PayNowIDParam.AccountParam.AccountID == "")) {
+		return &ValidationError{Field: "payNowIdParam", Message: "token or account of the saison card required"}
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package veritrans
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestValidateSaisonParams(t *testing.T) {
+	validParam := func() *Params {
+		return &Params{
+			OrderID:       "saison-order-1",
+			Amount:        "1000",
+			CardAmount:    "700",
+			PointAmount:   "300",
+			PayNowIDParam: &PayNowIDParam{Token: "test-token"},
+		}
+	}
+	assert.Nil(t, validateSaisonParams(validParam()))
+
+	// paid by the points only
+	param := validParam()
+	param.CardAmount, param.PointAmount = "0", "1000"
+	assert.Nil(t, validateSaisonParams(param))
+
+	invalidParams := []func(*Params){
+		func(p *Params) { p.OrderID = "" },
+		func(p *Params) { p.Amount = "0" },
+		func(p *Params) { p.PointAmount = "0" },
+		func(p *Params) { p.CardAmount = "" },
+		func(p *Params) { p.CardAmount = "-100" },
+		func(p *Params) { p.CardAmount = "800" },
+		func(p *Params) { p.PayNowIDParam = nil },
+		func(p *Params) { p.PayNowIDParam = &PayNowIDParam{AccountParam: &AccountParam{}} },
+	}
+	for _, modify := range invalidParams {
+		param := validParam()
+		modify(param)
+		err := validateSaisonParams(param)
+		assert.True(t, IsInvalidParameter(err), "%+v", param)
+	}
+}
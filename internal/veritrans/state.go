@@ -0,0 +1,72 @@
+package veritrans
+
+import (
+	"sync"
+	"time"
+)
+
+// DefaultStateTTL is the lifetime of the pending state waiting for the callback
+const DefaultStateTTL = 15 * time.Minute
+
+// PendingState is the state of the redirect payment kept between the authorize request and the callback
+type PendingState struct {
+	Params      Params
+	ServiceType PaymentServiceType
+	ExpiresAt   time.Time
+}
+
+// StateStore keeps the pending states by the order ID
+// The state is deleted only after the callback is completed, so a failed callback can be retried
+type StateStore interface {
+	Save(orderID string, state PendingState)
+	Get(orderID string) (*PendingState, bool)
+	Delete(orderID string)
+}
+
+type memoryStateStore struct {
+	mtx    sync.Mutex
+	states map[string]PendingState
+}
+
+// NewMemoryStateStore initializes the in-memory StateStore
+func NewMemoryStateStore() StateStore {
+	return &memoryStateStore{states: map[string]PendingState{}}
+}
+
+// Save function
+func (s *memoryStateStore) Save(orderID string, state PendingState) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	// drop the expired states which never got the callback
+	now := time.Now()
+	for key, item := range s.states {
+		if now.After(item.ExpiresAt) {
+			delete(s.states, key)
+		}
+	}
+	s.states[orderID] = state
+}
+
+// Get function
+func (s *memoryStateStore) Get(orderID string) (*PendingState, bool) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	state, ok := s.states[orderID]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(state.ExpiresAt) {
+		delete(s.states, orderID)
+		return nil, false
+	}
+	return &state, true
+}
+
+// Delete function
+func (s *memoryStateStore) Delete(orderID string) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	delete(s.states, orderID)
+}
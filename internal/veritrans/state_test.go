@@ -0,0 +1,28 @@
+package veritrans
+
+import (
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestMemoryStateStore(t *testing.T) {
+	store := NewMemoryStateStore()
+	store.Save("order-1", PendingState{Params: Params{Amount: "1000"}, ExpiresAt: time.Now().Add(time.Minute)})
+	store.Save("order-2", PendingState{ExpiresAt: time.Now().Add(-time.Minute)})
+
+	// a state is kept until it is deleted
+	state, ok := store.Get("order-1")
+	assert.True(t, ok)
+	assert.Equal(t, "1000", state.Params.Amount)
+	_, ok = store.Get("order-1")
+	assert.True(t, ok)
+	store.Delete("order-1")
+	_, ok = store.Get("order-1")
+	assert.False(t, ok)
+
+	// expired
+	_, ok = store.Get("order-2")
+	assert.False(t, ok)
+}
@@ -0,0 +1,29 @@
+package veritrans
+
+import "context"
+
+// PayWithToken authorizes the one-off card payment with the MDK token
+// The card is saved to the account in the same request when the account ID is given
+func (pay PaymentService) PayWithToken(ctx context.Context, param *Params) (*Result, error) {
+	if err := validateTokenPaymentParams(param); err != nil {
+		return nil, err
+	}
+	return pay.Authorize(ctx, param, PaymentServiceType(PayCard))
+}
+
+// Validate the token payment parameters
+func validateTokenPaymentParams(param *Params) error {
+	if err := validateOrderID(param); err != nil {
+		return err
+	}
+	if !isValidAmount(param.Amount) {
+		return &ValidationError{Field: "amount", Message: "must be a positive integer"}
+	}
+	if param.PayNowIDParam == nil || param.PayNowIDParam.Token == "" {
+		return &ValidationError{Field: "token", Message: "required"}
+	}
+	if param.PayNowIDParam.AccountParam != nil && param.PayNowIDParam.AccountParam.AccountID == "" {
+		return &ValidationError{Field: "accountId", Message: "required to save the card"}
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package veritrans
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestValidateTokenPaymentParams(t *testing.T) {
+	validParam := func() *Params {
+		return &Params{
+			OrderID:       "token-order-1",
+			Amount:        "1000",
+			PayNowIDParam: &PayNowIDParam{Token: "test-token"},
+		}
+	}
+	assert.Nil(t, validateTokenPaymentParams(validParam()))
+
+	// the card is saved to the account
+	param := validParam()
+	param.PayNowIDParam.AccountParam = &AccountParam{AccountID: "test-account"}
+	assert.Nil(t, validateTokenPaymentParams(param))
+
+	invalidParams := []func(*Params){
+		func(p *Params) { p.OrderID = "" },
+		func(p *Params) { p.Amount = "" },
+		func(p *Params) { p.PayNowIDParam = nil },
+		func(p *Params) { p.PayNowIDParam.Token = "" },
+		func(p *Params) { p.PayNowIDParam.AccountParam = &AccountParam{} },
+	}
+	for _, modify := range invalidParams {
+		param := validParam()
+		modify(param)
+		err := validateTokenPaymentParams(param)
+		assert.True(t, IsInvalidParameter(err), "%+v", param)
+	}
+}
@@ -0,0 +1,67 @@
+package veritrans
+
+import (
+	"context"
+	"net/url"
+	"path"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.7.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/david1992121/veritrans-microservice/internal/veritrans"
+
+// The span attributes of the veritrans requests.
+// Only these attributes are recorded so that no card data or secrets are exported.
+const (
+	AttributeOrderID     = attribute.Key("veritrans.order_id")
+	AttributeServiceType = attribute.Key("veritrans.service_type")
+	AttributeResultCode  = attribute.Key("veritrans.result_code")
+	AttributeMStatus     = attribute.Key("veritrans.mstatus")
+)
+
+// Start the client span of a veritrans api request
+func startRequestSpan(ctx context.Context, requestURL string) (context.Context, trace.Span) {
+	spanName := "veritrans request"
+	var attributes []attribute.KeyValue
+	if parsedURL, err := url.Parse(requestURL); err == nil {
+		spanName = "veritrans " + parsedURL.Path
+		attributes = append(attributes,
+			semconv.HTTPMethodKey.String("POST"),
+			semconv.HTTPTargetKey.String(parsedURL.Path),
+			AttributeServiceType.String(path.Base(parsedURL.Path)),
+		)
+	}
+
+	return otel.Tracer(tracerName).Start(ctx, spanName,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attributes...),
+	)
+}
+
+// End the client span with the result of the request
+func endRequestSpan(span trace.Span, vResultCode string, mStatus string, err error) {
+	if vResultCode != "" {
+		span.SetAttributes(AttributeResultCode.String(vResultCode))
+	}
+	if mStatus != "" {
+		span.SetAttributes(AttributeMStatus.String(mStatus))
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Set the http status code to the span of the request
+func setStatusCode(ctx context.Context, statusCode int) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(semconv.HTTPStatusCodeKey.Int(statusCode))
+	if statusCode >= 500 {
+		span.SetStatus(semconv.SpanStatusFromHTTPStatusCode(statusCode))
+	}
+}
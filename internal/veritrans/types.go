@@ -1,5 +1,11 @@
 package veritrans
 
+import (
+	"net/http"
+
+	"github.com/go-kit/log"
+)
+
 // EnvVariables is a list of the environment variables
 var EnvVariables = []string{
 	"MDK_API_TOKEN",
@@ -18,14 +24,24 @@ var EnvVariables = []string{
 // PaymentAPIURL is the payment api endpoint (https://api.veritrans.co.jp:443/paynow/v2)
 // TxnVersion is the version of the veritrans api (2.0.0)
 // DummyRequest is the flag indicating whether the request is dummy or live
+// HTTPClient is shared by the requests (a default client is used if nil)
+// RetryPolicy is applied to the requests which are safe to retry
+// Logger reports the retries (optional)
+// StateStore keeps the pending states of the redirect payments (an in-memory store is used if nil)
+// EnabledServiceTypes lists the payment services the merchant accepts (all services are enabled if empty)
 type ConnectionConfig struct {
-	MerchantCCID     string
-	MerchantPassword string
-	AccountAPIURL    string
-	PaymentAPIURL    string
-	SearchAPIURL     string
-	TxnVersion       string
-	DummyRequest     string
+	MerchantCCID        string
+	MerchantPassword    string
+	AccountAPIURL       string
+	PaymentAPIURL       string
+	SearchAPIURL        string
+	TxnVersion          string
+	DummyRequest        string
+	HTTPClient          *http.Client
+	RetryPolicy         RetryPolicy
+	Logger              log.Logger
+	StateStore          StateStore
+	EnabledServiceTypes []string
 }
 
 // Default interface fills default values
@@ -34,6 +50,8 @@ type Default interface {
 }
 
 // AccountBasicParam represents the "accountBasicParam" of the request.
+// DeleteDate schedules the deletion of the account (YYYYMMDD, deleted immediately if empty)
+// ForceDeleteDate deletes the account even with the active recurring charges ("0" if not forced)
 type AccountBasicParam struct {
 	CreateDate      string `json:"createDate,omitempty"`
 	DeleteDate      string `json:"deleteDate,omitempty"`
@@ -51,13 +69,17 @@ type CardParam struct {
 }
 
 // RecurringChargeParam represents the "recurringChargeParam" of the request.
+// GroupID is the recurring charge group registered on the veritrans merchant portal
+// StartDate and EndDate are the period of the recurring charge (YYYYMMDD)
+// FinalCharge charges the final month on the end date ("1") or not ("0")
+// OneTimeAmount is charged only on the first charge instead of the Amount
 type RecurringChargeParam struct {
 	GroupID       string `json:"groupId"`
 	StartDate     string `json:"startDate,omitempty"`
 	EndDate       string `json:"endDate,omitempty"`
 	FinalCharge   string `json:"finalCharge,omitempty"`
-	OneTimeAmount string `json:"oneTimeAmount"`
-	Amount        string `json:"amount"`
+	OneTimeAmount string `json:"oneTimeAmount,omitempty"`
+	Amount        string `json:"amount,omitempty"`
 }
 
 // AccountParam represents the "accountParam" of the request.
@@ -76,9 +98,16 @@ type PayNowIDParam struct {
 	FreeKey      string        `json:"freeKey,omitempty"`
 }
 
+// DateRange represents a "from" - "to" range of the search parameters.
+type DateRange struct {
+	From string `json:"from,omitempty"`
+	To   string `json:"to,omitempty"`
+}
+
 // OrderParam struct
 type OrderParam struct {
-	OrderID string `json:"orderId"`
+	OrderID     string     `json:"orderId,omitempty"`
+	TxnDatetime *DateRange `json:"txnDatetime,omitempty"`
 }
 
 // SearchParam represents the "searchParameters" of the request.
@@ -88,18 +117,47 @@ type SearchParam struct {
 
 // Params represents the "params" of the request.
 type Params struct {
-	OrderID          string         `json:"orderId,omitempty"`
-	Amount           string         `json:"amount,omitempty"`
-	JPO              string         `json:"jpo,omitempty"`
-	WithCapture      string         `json:"withCapture,omitempty"`
-	PayNowIDParam    *PayNowIDParam `json:"payNowIdParam,omitempty"`
-	ContainDummyFlag string         `json:"containDummyFlag,omitempty"`
-	ServiceTypeCd    []string       `json:"serviceTypeCd,omitempty"`
-	NewerFlag        string         `json:"newerFlag,omitempty"`
-	SearchParam      *SearchParam   `json:"searchParameters,omitempty"`
-	TxnVersion       string         `json:"txnVersion,omitempty"`
-	DummyRequest     string         `json:"dummyRequest,omitempty"`
-	MerchantCCID     string         `json:"merchantCcid,omitempty"`
+	OrderID           string         `json:"orderId,omitempty"`
+	OriginalOrderID   string         `json:"originalOrderId,omitempty"`
+	Amount            string         `json:"amount,omitempty"`
+	JPO               string         `json:"jpo,omitempty"`
+	PaymentOption     *PaymentOption `json:"paymentOption,omitempty"`
+	WithCapture       string         `json:"withCapture,omitempty"`
+	PayNowIDParam     *PayNowIDParam `json:"payNowIdParam,omitempty"`
+	ServiceOptionType string         `json:"serviceOptionType,omitempty"`
+	Name1             string         `json:"name1,omitempty"`
+	Name2             string         `json:"name2,omitempty"`
+	TelNo             string         `json:"telNo,omitempty"`
+	PayLimit          string         `json:"payLimit,omitempty"`
+	PayLimitHhmm      string         `json:"payLimitHhmm,omitempty"`
+	Kana1             string         `json:"kana1,omitempty"`
+	Kana2             string         `json:"kana2,omitempty"`
+	Contents          string         `json:"contents,omitempty"`
+	ContentsKana      string         `json:"contentsKana,omitempty"`
+	RedirectionURI    string         `json:"redirectionUri,omitempty"`
+	HTTPUserAgent     string         `json:"httpUserAgent,omitempty"`
+	HTTPAccept        string         `json:"httpAccept,omitempty"`
+	Action            string         `json:"action,omitempty"`
+	ReturnURL         string         `json:"returnUrl,omitempty"`
+	CancelURL         string         `json:"cancelUrl,omitempty"`
+	Token             string         `json:"token,omitempty"`
+	PayerID           string         `json:"payerId,omitempty"`
+	Currency          string         `json:"currency,omitempty"`
+	CommodityName     string         `json:"commodityName,omitempty"`
+	TerminalKind      string         `json:"terminalKind,omitempty"`
+	ItemType          string         `json:"itemType,omitempty"`
+	SuccessURL        string         `json:"successUrl,omitempty"`
+	ErrorURL          string         `json:"errorUrl,omitempty"`
+	MailAddr          string         `json:"mailAddr,omitempty"`
+	CardAmount        string         `json:"cardAmount,omitempty"`
+	PointAmount       string         `json:"pointAmount,omitempty"`
+	ContainDummyFlag  string         `json:"containDummyFlag,omitempty"`
+	ServiceTypeCd     []string       `json:"serviceTypeCd,omitempty"`
+	NewerFlag         string         `json:"newerFlag,omitempty"`
+	SearchParam       *SearchParam   `json:"searchParameters,omitempty"`
+	TxnVersion        string         `json:"txnVersion,omitempty"`
+	DummyRequest      string         `json:"dummyRequest,omitempty"`
+	MerchantCCID      string         `json:"merchantCcid,omitempty"`
 }
 
 // ConnectionParam represents the request parameter.
@@ -135,10 +193,12 @@ const (
 	AccountType AccountServiceType = iota
 	// CardType represents the card service
 	CardType
+	// RecurringChargeType represents the recurring charge service
+	RecurringChargeType
 )
 
 // AccountServiceTypes is list of services
-var AccountServiceTypes = []string{"account", "cardinfo"}
+var AccountServiceTypes = []string{"account", "cardinfo", "recurringCharge"}
 
 // PaymentManagementMode is the enum type for the payment mode
 type PaymentManagementMode int32
@@ -152,10 +212,14 @@ const (
 	MethodCancel
 	// MethodSearch indicates a Search
 	MethodSearch
+	// MethodRefund indicates a Refund
+	MethodRefund
+	// MethodReAuthorize indicates a ReAuthorize
+	MethodReAuthorize
 )
 
 // PaymentManagementModes a list of methods
-var PaymentManagementModes = []string{"Authorize", "Capture", "Cancel", "Search"}
+var PaymentManagementModes = []string{"Authorize", "Capture", "Cancel", "Search", "Refund", "ReAuthorize"}
 
 // PaymentServiceType represents the payment service
 type PaymentServiceType int32
@@ -188,6 +252,27 @@ const (
 // PaymentServiceTypes is a list of services
 var PaymentServiceTypes = []string{"card", "mpi", "cvs", "em", "bank", "upop", "paypal", "saison", "alipay", "carrier", "search"}
 
+// String returns the name of the payment service
+func (serviceType PaymentServiceType) String() string {
+	if serviceType < 0 || int(serviceType) >= len(PaymentServiceTypes) {
+		return "unknown"
+	}
+	return PaymentServiceTypes[serviceType]
+}
+
+// GetPaymentServiceType returns the payment service of the name (the card if empty)
+func GetPaymentServiceType(name string) (PaymentServiceType, error) {
+	if name == "" {
+		return PayCard, nil
+	}
+	for i, serviceType := range PaymentServiceTypes {
+		if serviceType == name && PaymentServiceType(i) != Search {
+			return PaymentServiceType(i), nil
+		}
+	}
+	return PayCard, &ValidationError{Field: "serviceType", Message: "unknown payment service " + name}
+}
+
 // Default function for the PayNowIDParam
 func (payParam *PayNowIDParam) Default() {
 	if payParam.Memo == "" {
@@ -214,10 +299,31 @@ func (recurringChargeParam *RecurringChargeParam) Default() {
 
 // Result indicates the response of api
 type Result struct {
-	VResultCode string      `json:"vResultCode"`
-	MStatus     string      `json:"mstatus"`
-	MErrorMsg   string      `json:"merrMsg"`
-	OrderInfos  *OrderInfos `json:"orderInfos"`
+	VResultCode         string      `json:"vResultCode"`
+	MStatus             string      `json:"mstatus"`
+	MErrorMsg           string      `json:"merrMsg"`
+	OrderID             string      `json:"orderId,omitempty"`
+	ServiceType         string      `json:"serviceType,omitempty"`
+	CustTxn             string      `json:"custTxn,omitempty"`
+	MarchTxn            string      `json:"marchTxn,omitempty"`
+	ReqAmount           string      `json:"reqAmount,omitempty"`
+	CardTransactionType string      `json:"cardTransactionType,omitempty"`
+	CenterResponseDate  string      `json:"centerResponseDate,omitempty"`
+	ReceiptNo           string      `json:"receiptNo,omitempty"`
+	HaraikomiURL        string      `json:"haraikomiUrl,omitempty"`
+	ShunoKikanNo        string      `json:"shunoKikanNo,omitempty"`
+	CustomerNo          string      `json:"customerNo,omitempty"`
+	ConfirmNo           string      `json:"confirmNo,omitempty"`
+	BillPattern         string      `json:"billPattern,omitempty"`
+	URL                 string      `json:"url,omitempty"`
+	ResResponseContents string      `json:"resResponseContents,omitempty"`
+	LoginURL            string      `json:"loginUrl,omitempty"`
+	RedirectURL         string      `json:"redirectUrl,omitempty"`
+	CarrierOrderID      string      `json:"carrierOrderId,omitempty"`
+	AppURL              string      `json:"appUrl,omitempty"`
+	MailURL             string      `json:"mailUrl,omitempty"`
+	RefundableAmount    string      `json:"refundableAmount,omitempty"`
+	OrderInfos          *OrderInfos `json:"orderInfos,omitempty"`
 }
 
 // ProperTransactionInfo struct
@@ -243,6 +349,20 @@ type TransactionInfos struct {
 	TransactionInfo []TransactionInfo `json:"transactionInfo"`
 }
 
+// ProperOrderInfo is the service specific information of the order
+// ShunoKikanNo, CustomerNo, ConfirmNo and PayLimit are set on the bank orders
+// ReceivedDatetime is empty until the bank payment is received
+// CardAmount and PointAmount are the split of the saison orders paid by the card and the points
+type ProperOrderInfo struct {
+	ShunoKikanNo     string `json:"shunoKikanNo,omitempty"`
+	CustomerNo       string `json:"customerNo,omitempty"`
+	ConfirmNo        string `json:"confirmNo,omitempty"`
+	PayLimit         string `json:"payLimit,omitempty"`
+	ReceivedDatetime string `json:"receivedDatetime,omitempty"`
+	CardAmount       string `json:"cardAmount,omitempty"`
+	PointAmount      string `json:"pointAmount,omitempty"`
+}
+
 // OrderInfo struct
 type OrderInfo struct {
 	AccountID          string            `json:"accountId"`
@@ -250,6 +370,7 @@ type OrderInfo struct {
 	OrderID            string            `json:"orderId"`
 	ServiceTypeCd      string            `json:"serviceTypeCd"`
 	LastSuccessTxnType string            `json:"lastSuccessTxnType"`
+	ProperOrderInfo    *ProperOrderInfo  `json:"properOrderInfo,omitempty"`
 	TransactionInfos   *TransactionInfos `json:"transactionInfos"`
 }
 
@@ -266,10 +387,22 @@ type CardInfo struct {
 	DefaultCard string `json:"defaultCard"`
 }
 
+// RecurringCharge struct
+type RecurringCharge struct {
+	GroupID       string `json:"groupId"`
+	StartDate     string `json:"startDate"`
+	EndDate       string `json:"endDate"`
+	FinalCharge   string `json:"finalCharge"`
+	OneTimeAmount string `json:"oneTimeAmount"`
+	Amount        string `json:"amount"`
+}
+
 // Account struct
 type Account struct {
-	AccountID string     `json:"accountId"`
-	CardInfo  []CardInfo `json:"cardInfo"`
+	AccountID         string             `json:"accountId"`
+	CardInfo          []CardInfo         `json:"cardInfo"`
+	AccountBasicParam *AccountBasicParam `json:"accountBasicParam,omitempty"`
+	RecurringCharge   []RecurringCharge  `json:"recurringCharge,omitempty"`
 }
 
 // PayNowIDResponse struct
@@ -2,14 +2,16 @@ package veritrans
 
 import (
 	"bytes"
+	"context"
 	"crypto/sha256"
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
+	"io"
 	"math"
 	"math/rand"
 	"net/http"
 	"net/url"
+	"strconv"
 	"time"
 )
 
@@ -27,6 +29,47 @@ func GetAfterOneYear() string {
 	return expiredAt.Format("01/06")
 }
 
+// Check if the date is formatted in YYYYMMDD
+func isValidDate(date string) bool {
+	_, err := time.Parse("20060102", date)
+	return len(date) == 8 && err == nil
+}
+
+// Check if the amount is a positive integer
+func isValidAmount(amount string) bool {
+	value, err := strconv.ParseUint(amount, 10, 64)
+	return err == nil && value > 0
+}
+
+// Get the today in the japan standard time (YYYYMMDD)
+func getToday() string {
+	return time.Now().In(time.FixedZone("JST", 9*60*60)).Format("20060102")
+}
+
+// Check if the order ID is provided
+func validateOrderID(param *Params) error {
+	if param == nil || param.OrderID == "" {
+		return &ValidationError{Field: "orderId", Message: "required"}
+	}
+	return nil
+}
+
+// Check if the url is an absolute http(s) url the customer can be redirected to
+func isValidRedirectURL(rawURL string) bool {
+	parsedURL, err := url.ParseRequestURI(rawURL)
+	return err == nil && (parsedURL.Scheme == "https" || parsedURL.Scheme == "http") && parsedURL.Host != ""
+}
+
+// Check if the list contains the value
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
 // GetRandomID function
 func GetRandomID(digit int) int {
 	rand.Seed(time.Now().UnixNano())
@@ -36,7 +79,23 @@ func GetRandomID(digit int) int {
 }
 
 // ProcessRequest function
-func ProcessRequest(requestURL string, connectionParam *ConnectionParam) (*ConnectionResponse, error) {
+func ProcessRequest(ctx context.Context, httpClient *http.Client, requestURL string, connectionParam *ConnectionParam) (*ConnectionResponse, error) {
+	ctx, span := startRequestSpan(ctx, requestURL)
+	if connectionParam != nil && connectionParam.Params.OrderID != "" {
+		span.SetAttributes(AttributeOrderID.String(connectionParam.Params.OrderID))
+	}
+
+	res, err := processRequest(ctx, httpClient, requestURL, connectionParam)
+	if res != nil {
+		endRequestSpan(span, res.Result.VResultCode, res.Result.MStatus, err)
+	} else {
+		endRequestSpan(span, "", "", err)
+	}
+	return res, err
+}
+
+// Send the request to the veritrans api and decode the response
+func processRequest(ctx context.Context, httpClient *http.Client, requestURL string, connectionParam *ConnectionParam) (*ConnectionResponse, error) {
 	var err error
 	paramByte, err := json.Marshal(connectionParam)
 	if err != nil {
@@ -48,20 +107,24 @@ func ProcessRequest(requestURL string, connectionParam *ConnectionParam) (*Conne
 		return nil, err
 	}
 
-	httpClient := &http.Client{}
 	body := bytes.NewBuffer(paramByte)
-	req, err := http.NewRequest("POST", parsedURL.String(), body)
+	req, err := http.NewRequestWithContext(ctx, "POST", parsedURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 	req.Header.Set("Content-Type", "application/json")
 
-	res, err := httpClient.Do(req)
+	res, err := doRequest(httpClient, req)
 	if err != nil {
 		return nil, err
 	}
+	defer drainBody(res.Body)
+
+	if res.StatusCode >= http.StatusInternalServerError {
+		return nil, &HTTPError{StatusCode: res.StatusCode, URL: parsedURL.String()}
+	}
 
-	resBody, err := ioutil.ReadAll(res.Body)
+	resBody, err := io.ReadAll(res.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,100 @@
+package veritrans
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestProcessRequestCancel(t *testing.T) {
+	released := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-released:
+		}
+	}))
+	defer server.Close()
+	defer close(released)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := ProcessRequest(ctx, server.Client(), server.URL, &ConnectionParam{})
+	assert.NotNil(t, err)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+}
+
+func TestProcessRequestReadTimeout(t *testing.T) {
+	released := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-released:
+		}
+	}))
+	defer server.Close()
+	defer close(released)
+
+	clientConfig := DefaultClientConfig()
+	clientConfig.ReadTimeout = 50 * time.Millisecond
+	httpClient := NewHTTPClient(clientConfig)
+
+	begin := time.Now()
+	_, err := ProcessRequest(context.Background(), httpClient, server.URL, &ConnectionParam{})
+	assert.NotNil(t, err)
+	assert.Less(t, time.Since(begin), 5*time.Second)
+}
+
+func TestProcessRequestObserver(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"result":{"mstatus":"success"}}`))
+	}))
+	defer server.Close()
+
+	var observed []string
+	ctx := WithRequestObserver(context.Background(), func(api string, status string, took time.Duration) {
+		observed = append(observed, api, status)
+	})
+
+	_, err := ProcessRequest(ctx, server.Client(), server.URL+"/Authorize/card", &ConnectionParam{})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"/Authorize/card", "200"}, observed)
+}
+
+func TestProcessRequestTracing(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"result":{"vResultCode":"A001000000000000","mstatus":"success"}}`))
+	}))
+	defer server.Close()
+
+	exporter := tracetest.NewInMemoryExporter()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+
+	_, err := ProcessRequest(context.Background(), server.Client(), server.URL+"/Authorize/card", &ConnectionParam{
+		Params: Params{OrderID: "order-1"},
+	})
+	assert.Nil(t, err)
+
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 1)
+	assert.Equal(t, "veritrans /Authorize/card", spans[0].Name)
+
+	attributes := map[attribute.Key]string{}
+	for _, item := range spans[0].Attributes {
+		attributes[item.Key] = item.Value.Emit()
+	}
+	assert.Equal(t, "order-1", attributes[AttributeOrderID])
+	assert.Equal(t, "card", attributes[AttributeServiceType])
+	assert.Equal(t, "A001000000000000", attributes[AttributeResultCode])
+	assert.Equal(t, "200", attributes["http.status_code"])
+}
@@ -0,0 +1,70 @@
+package veritranstest
+
+import (
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/david1992121/veritrans-microservice/internal/veritrans"
+)
+
+// Authenticate returns the signed callback values which the ACS posts to the redirection uri
+func (server *Server) Authenticate(orderID string, authenticated bool) url.Values {
+	values := url.Values{
+		"orderId":     {orderID},
+		"mstatus":     {"success"},
+		"vResultCode": {"G001000000000000"},
+		"custTxn":     {"mpi-" + orderID},
+	}
+	if !authenticated {
+		values.Set("mstatus", "failure")
+		values.Set("vResultCode", "G012000000000000")
+		values.Set("merrMsg", "3-D secure authentication failed")
+	}
+	return server.sign(values)
+}
+
+func (server *Server) handleAuthorizeMPI(w http.ResponseWriter, r *http.Request) {
+	params, ok := server.decodeRequest(w, r)
+	if !ok {
+		return
+	}
+
+	server.mtx.Lock()
+	server.redirectionURIs[params.OrderID] = params.RedirectionURI
+	server.mtx.Unlock()
+
+	contents := fmt.Sprintf(`<html><body onload="document.forms[0].submit()">`+
+		`<form method="post" action="%s/acs"><input type="hidden" name="orderId" value="%s"></form></body></html>`,
+		server.URL, html.EscapeString(params.OrderID))
+	writeResult(w, veritrans.Result{
+		VResultCode:         "G001000000000000",
+		MStatus:             "success",
+		OrderID:             params.OrderID,
+		ServiceType:         veritrans.PaymentServiceTypes[veritrans.MPI],
+		ReqAmount:           params.Amount,
+		ResResponseContents: contents,
+	})
+}
+
+// Authenticate the customer and post the result back to the redirection uri
+func (server *Server) handleACS(w http.ResponseWriter, r *http.Request) {
+	orderID := r.FormValue("orderId")
+	server.mtx.Lock()
+	redirectionURI, ok := server.redirectionURIs[orderID]
+	server.mtx.Unlock()
+	if !ok {
+		http.Error(w, "unknown order", http.StatusNotFound)
+		return
+	}
+
+	var inputs strings.Builder
+	for key, value := range server.Authenticate(orderID, true) {
+		fmt.Fprintf(&inputs, `<input type="hidden" name="%s" value="%s">`, html.EscapeString(key), html.EscapeString(value[0]))
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	fmt.Fprintf(w, `<html><body onload="document.forms[0].submit()"><form method="post" action="%s">%s</form></body></html>`,
+		html.EscapeString(redirectionURI), inputs.String())
+}
@@ -0,0 +1,55 @@
+package veritranstest
+
+import (
+	"net/http"
+
+	"github.com/david1992121/veritrans-microservice/internal/veritrans"
+)
+
+// Answer the pay-easy authorize request with the payment numbers, and the net banking url unless paid at the atm
+func (server *Server) handleAuthorizeBank(w http.ResponseWriter, r *http.Request) {
+	params, ok := server.decodeRequest(w, r)
+	if !ok {
+		return
+	}
+	properOrderInfo := &veritrans.ProperOrderInfo{
+		ShunoKikanNo: "58191",
+		CustomerNo:   "0000" + params.OrderID,
+		ConfirmNo:    "123456",
+		PayLimit:     params.PayLimit,
+	}
+	server.recordTransaction(params, veritrans.Bank, veritrans.PaymentManagementModes[veritrans.MethodAuthorize], properOrderInfo)
+
+	result := veritrans.Result{
+		VResultCode:  "N001000000000000",
+		MStatus:      "success",
+		OrderID:      params.OrderID,
+		ServiceType:  veritrans.PaymentServiceTypes[veritrans.Bank],
+		CustTxn:      "bank-" + params.OrderID,
+		ReqAmount:    params.Amount,
+		ShunoKikanNo: properOrderInfo.ShunoKikanNo,
+		CustomerNo:   properOrderInfo.CustomerNo,
+		ConfirmNo:    properOrderInfo.ConfirmNo,
+	}
+	if params.ServiceOptionType != veritrans.BankServiceOptions[veritrans.ATM] {
+		result.BillPattern = "1"
+		result.URL = server.URL + "/bank/login?orderId=" + params.OrderID
+	}
+	writeResult(w, result)
+}
+
+// Answer the pay-easy cancel, and record it for the search
+func (server *Server) handleCancelBank(w http.ResponseWriter, r *http.Request) {
+	params, ok := server.decodeRequest(w, r)
+	if !ok {
+		return
+	}
+	server.recordTransaction(params, veritrans.Bank, veritrans.PaymentManagementModes[veritrans.MethodCancel], nil)
+
+	writeResult(w, veritrans.Result{
+		VResultCode: "N001000000000000",
+		MStatus:     "success",
+		OrderID:     params.OrderID,
+		ServiceType: veritrans.PaymentServiceTypes[veritrans.Bank],
+	})
+}
@@ -0,0 +1,88 @@
+package veritranstest
+
+import (
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+
+	"github.com/david1992121/veritrans-microservice/internal/veritrans"
+)
+
+// PayCarrier returns the signed values which the carrier gives to the success or cancel url
+func (server *Server) PayCarrier(orderID string, paid bool) url.Values {
+	values := url.Values{
+		"orderId":        {orderID},
+		"mstatus":        {"success"},
+		"vResultCode":    {"Y001000000000000"},
+		"custTxn":        {"carrier-" + orderID},
+		"carrierOrderId": {"C-" + orderID},
+	}
+	if !paid {
+		values.Del("carrierOrderId")
+		values.Set("mstatus", "failure")
+		values.Set("vResultCode", "YA99000000000000")
+		values.Set("merrMsg", "payment canceled by the customer")
+	}
+	return server.sign(values)
+}
+
+// Answer the authorize request with the url of the fake carrier page, or the html redirecting to it on the feature phones
+func (server *Server) handleAuthorizeCarrier(w http.ResponseWriter, r *http.Request) {
+	params, ok := server.decodeRequest(w, r)
+	if !ok {
+		return
+	}
+
+	server.mtx.Lock()
+	server.carrierParams[params.OrderID] = *params
+	server.mtx.Unlock()
+
+	result := veritrans.Result{
+		VResultCode: "Y001000000000000",
+		MStatus:     "success",
+		OrderID:     params.OrderID,
+		ServiceType: veritrans.PaymentServiceTypes[veritrans.Carrier],
+		ReqAmount:   params.Amount,
+	}
+	payURL := server.URL + "/carrier/pay?" + url.Values{"orderId": {params.OrderID}}.Encode()
+	if params.TerminalKind == veritrans.CarrierTerminalKinds[veritrans.TerminalFeaturePhone] {
+		result.ResResponseContents = fmt.Sprintf(`<html><body><a href="%s">pay</a></body></html>`, html.EscapeString(payURL))
+	} else {
+		result.RedirectURL = payURL
+	}
+	writeResult(w, result)
+}
+
+func (server *Server) handleCarrierResult(w http.ResponseWriter, r *http.Request) {
+	params, ok := server.decodeRequest(w, r)
+	if !ok {
+		return
+	}
+
+	writeResult(w, veritrans.Result{
+		VResultCode: "Y001000000000000",
+		MStatus:     "success",
+		OrderID:     params.OrderID,
+		ServiceType: veritrans.PaymentServiceTypes[veritrans.Carrier],
+		ReqAmount:   params.Amount,
+	})
+}
+
+// Redirect the customer back to the success url, or the cancel url with "cancel=true"
+func (server *Server) handleCarrierPay(w http.ResponseWriter, r *http.Request) {
+	orderID := r.FormValue("orderId")
+	server.mtx.Lock()
+	params, ok := server.carrierParams[orderID]
+	server.mtx.Unlock()
+	if !ok {
+		http.Error(w, "unknown order", http.StatusNotFound)
+		return
+	}
+
+	if r.FormValue("cancel") == "true" {
+		redirectWithValues(w, r, params.CancelURL, server.PayCarrier(orderID, false))
+		return
+	}
+	redirectWithValues(w, r, params.SuccessURL, server.PayCarrier(orderID, true))
+}
@@ -0,0 +1,43 @@
+package veritranstest
+
+import (
+	"net/http"
+
+	"github.com/david1992121/veritrans-microservice/internal/veritrans"
+)
+
+// Answer the convenience store authorize request with the receipt number and the payment slip
+func (server *Server) handleAuthorizeCVS(w http.ResponseWriter, r *http.Request) {
+	params, ok := server.decodeRequest(w, r)
+	if !ok {
+		return
+	}
+	server.recordTransaction(params, veritrans.CVS, veritrans.PaymentManagementModes[veritrans.MethodAuthorize], nil)
+
+	writeResult(w, veritrans.Result{
+		VResultCode:  "M001000000000000",
+		MStatus:      "success",
+		OrderID:      params.OrderID,
+		ServiceType:  veritrans.PaymentServiceTypes[veritrans.CVS],
+		CustTxn:      "cvs-" + params.OrderID,
+		ReqAmount:    params.Amount,
+		ReceiptNo:    "R-" + params.OrderID,
+		HaraikomiURL: server.URL + "/cvs/slip?orderId=" + params.OrderID,
+	})
+}
+
+// Answer the convenience store cancel, and record it for the search
+func (server *Server) handleCancelCVS(w http.ResponseWriter, r *http.Request) {
+	params, ok := server.decodeRequest(w, r)
+	if !ok {
+		return
+	}
+	server.recordTransaction(params, veritrans.CVS, veritrans.PaymentManagementModes[veritrans.MethodCancel], nil)
+
+	writeResult(w, veritrans.Result{
+		VResultCode: "M001000000000000",
+		MStatus:     "success",
+		OrderID:     params.OrderID,
+		ServiceType: veritrans.PaymentServiceTypes[veritrans.CVS],
+	})
+}
@@ -0,0 +1,48 @@
+package veritranstest
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/david1992121/veritrans-microservice/internal/veritrans"
+)
+
+// Answer the electronic money authorize request with the app link or the mail link
+func (server *Server) handleAuthorizeEM(w http.ResponseWriter, r *http.Request) {
+	params, ok := server.decodeRequest(w, r)
+	if !ok {
+		return
+	}
+
+	result := veritrans.Result{
+		VResultCode: "E001000000000000",
+		MStatus:     "success",
+		OrderID:     params.OrderID,
+		ServiceType: veritrans.PaymentServiceTypes[veritrans.EM],
+		CustTxn:     "em-" + params.OrderID,
+		ReqAmount:   params.Amount,
+		ReceiptNo:   "R-" + params.OrderID,
+	}
+	switch {
+	case strings.HasSuffix(params.ServiceOptionType, "-app"):
+		result.AppURL = server.URL + "/em/app?orderId=" + params.OrderID
+	case strings.HasSuffix(params.ServiceOptionType, "-mail"):
+		result.MailURL = server.URL + "/em/mail?orderId=" + params.OrderID
+	}
+	writeResult(w, result)
+}
+
+func (server *Server) handleEMResult(w http.ResponseWriter, r *http.Request) {
+	params, ok := server.decodeRequest(w, r)
+	if !ok {
+		return
+	}
+
+	writeResult(w, veritrans.Result{
+		VResultCode: "E001000000000000",
+		MStatus:     "success",
+		OrderID:     params.OrderID,
+		ServiceType: veritrans.PaymentServiceTypes[veritrans.EM],
+		ReqAmount:   params.Amount,
+	})
+}
@@ -0,0 +1,82 @@
+package veritranstest
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/david1992121/veritrans-microservice/internal/veritrans"
+)
+
+// Pay returns the signed values which alipay or unionpay gives to the return url
+func (server *Server) Pay(orderID string, serviceType veritrans.PaymentServiceType, paid bool) url.Values {
+	values := url.Values{
+		"orderId":     {orderID},
+		"mstatus":     {"success"},
+		"vResultCode": {"X001000000000000"},
+		"custTxn":     {veritrans.PaymentServiceTypes[serviceType] + "-" + orderID},
+	}
+	if !paid {
+		values.Set("mstatus", "failure")
+		values.Set("vResultCode", "XA99000000000000")
+		values.Set("merrMsg", "payment canceled by the customer")
+	}
+	return server.sign(values)
+}
+
+// Answer the authorize request of alipay or unionpay with the url of the fake payment page
+func (server *Server) handleAuthorizeForeign(serviceType veritrans.PaymentServiceType) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		params, ok := server.decodeRequest(w, r)
+		if !ok {
+			return
+		}
+
+		server.mtx.Lock()
+		server.foreignParams[params.OrderID] = *params
+		server.mtx.Unlock()
+
+		service := veritrans.PaymentServiceTypes[serviceType]
+		writeResult(w, veritrans.Result{
+			VResultCode: "X001000000000000",
+			MStatus:     "success",
+			OrderID:     params.OrderID,
+			ServiceType: service,
+			ReqAmount:   params.Amount,
+			RedirectURL: server.URL + "/" + service + "/pay?" + url.Values{"orderId": {params.OrderID}}.Encode(),
+		})
+	}
+}
+
+// Answer the refund request of alipay or unionpay
+func (server *Server) handleRefundForeign(serviceType veritrans.PaymentServiceType) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		params, ok := server.decodeRequest(w, r)
+		if !ok {
+			return
+		}
+
+		writeResult(w, veritrans.Result{
+			VResultCode: "X001000000000000",
+			MStatus:     "success",
+			OrderID:     params.OrderID,
+			ServiceType: veritrans.PaymentServiceTypes[serviceType],
+			ReqAmount:   params.Amount,
+		})
+	}
+}
+
+// Redirect the customer back to the return url with the signed payment result
+func (server *Server) handleForeignPay(serviceType veritrans.PaymentServiceType) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		orderID := r.FormValue("orderId")
+		server.mtx.Lock()
+		params, ok := server.foreignParams[orderID]
+		server.mtx.Unlock()
+		if !ok {
+			http.Error(w, "unknown order", http.StatusNotFound)
+			return
+		}
+
+		redirectWithValues(w, r, params.ReturnURL, server.Pay(orderID, serviceType, r.FormValue("cancel") != "true"))
+	}
+}
@@ -0,0 +1,80 @@
+package veritranstest
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/david1992121/veritrans-microservice/internal/veritrans"
+)
+
+// PaypalPayerID is the payer ID given to the return url when the customer approves the payment
+const PaypalPayerID = "TESTPAYER01"
+
+func (server *Server) handleAuthorizePaypal(w http.ResponseWriter, r *http.Request) {
+	params, ok := server.decodeRequest(w, r)
+	if !ok {
+		return
+	}
+
+	result := veritrans.Result{
+		MStatus:     "success",
+		OrderID:     params.OrderID,
+		ServiceType: veritrans.PaymentServiceTypes[veritrans.Paypal],
+		ReqAmount:   params.Amount,
+	}
+	switch params.Action {
+	case veritrans.PaypalActions[veritrans.PaypalSet]:
+		token := "EC-" + params.OrderID
+		server.mtx.Lock()
+		server.paypalParams[token] = *params
+		server.mtx.Unlock()
+
+		result.VResultCode = "P001000000000000"
+		result.LoginURL = server.URL + "/paypal/login?" + url.Values{"token": {token}}.Encode()
+	case veritrans.PaypalActions[veritrans.PaypalDo]:
+		if params.Token != "EC-"+params.OrderID || params.PayerID != PaypalPayerID {
+			result.MStatus = "failure"
+			result.VResultCode = "MA99000000000000"
+			break
+		}
+		result.VResultCode = "P001000000000000"
+		result.CustTxn = "paypal-" + params.OrderID
+	default:
+		result.MStatus = "failure"
+		result.VResultCode = "MA99000000000000"
+	}
+	writeResult(w, result)
+}
+
+func (server *Server) handlePaypalResult(w http.ResponseWriter, r *http.Request) {
+	params, ok := server.decodeRequest(w, r)
+	if !ok {
+		return
+	}
+
+	writeResult(w, veritrans.Result{
+		VResultCode: "P001000000000000",
+		MStatus:     "success",
+		OrderID:     params.OrderID,
+		ServiceType: veritrans.PaymentServiceTypes[veritrans.Paypal],
+		ReqAmount:   params.Amount,
+	})
+}
+
+// Redirect the customer back to the return url, or the cancel url with "cancel=true"
+func (server *Server) handlePaypalLogin(w http.ResponseWriter, r *http.Request) {
+	token := r.FormValue("token")
+	server.mtx.Lock()
+	params, ok := server.paypalParams[token]
+	server.mtx.Unlock()
+	if !ok {
+		http.Error(w, "unknown token", http.StatusNotFound)
+		return
+	}
+
+	redirectURL, query := params.ReturnURL, url.Values{"token": {token}, "PayerID": {PaypalPayerID}}
+	if r.FormValue("cancel") == "true" {
+		redirectURL, query = params.CancelURL, url.Values{"token": {token}}
+	}
+	redirectWithValues(w, r, redirectURL, query)
+}
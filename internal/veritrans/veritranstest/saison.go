@@ -0,0 +1,32 @@
+package veritranstest
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/david1992121/veritrans-microservice/internal/veritrans"
+)
+
+// Answer the saison request and record the order with the split of the card and the points
+func (server *Server) handleSaison(w http.ResponseWriter, r *http.Request) {
+	params, ok := server.decodeRequest(w, r)
+	if !ok {
+		return
+	}
+
+	command := strings.Split(strings.TrimPrefix(r.URL.Path, "/"), "/")[0]
+	var properOrderInfo *veritrans.ProperOrderInfo
+	if command == veritrans.PaymentManagementModes[veritrans.MethodAuthorize] {
+		properOrderInfo = &veritrans.ProperOrderInfo{CardAmount: params.CardAmount, PointAmount: params.PointAmount}
+	}
+	server.recordTransaction(params, veritrans.Saison, command, properOrderInfo)
+
+	writeResult(w, veritrans.Result{
+		VResultCode: "S001000000000000",
+		MStatus:     "success",
+		OrderID:     params.OrderID,
+		ServiceType: veritrans.PaymentServiceTypes[veritrans.Saison],
+		CustTxn:     "saison-" + params.OrderID,
+		ReqAmount:   params.Amount,
+	})
+}
@@ -0,0 +1,83 @@
+package veritranstest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/david1992121/veritrans-microservice/internal/veritrans"
+)
+
+// Record the successful transaction of the order for the search
+func (server *Server) recordTransaction(params *veritrans.Params, serviceType veritrans.PaymentServiceType, command string, properOrderInfo *veritrans.ProperOrderInfo) {
+	server.mtx.Lock()
+	defer server.mtx.Unlock()
+
+	order, ok := server.orders[params.OrderID]
+	if !ok {
+		order = &veritrans.OrderInfo{
+			OrderID:          params.OrderID,
+			ServiceTypeCd:    veritrans.PaymentServiceTypes[serviceType],
+			TransactionInfos: &veritrans.TransactionInfos{},
+		}
+		if params.PayNowIDParam != nil && params.PayNowIDParam.AccountParam != nil {
+			order.AccountID = params.PayNowIDParam.AccountParam.AccountID
+		}
+		server.orders[params.OrderID] = order
+		server.orderIDs = append(server.orderIDs, params.OrderID)
+	}
+	if properOrderInfo != nil {
+		order.ProperOrderInfo = properOrderInfo
+	}
+	order.LastSuccessTxnType = command
+	order.TransactionInfos.TransactionInfo = append(order.TransactionInfos.TransactionInfo, veritrans.TransactionInfo{
+		Amount:      params.Amount,
+		Command:     command,
+		MStatus:     "success",
+		ProperInfo:  veritrans.ProperTransactionInfo{ReqWithCapture: params.WithCapture, ReqJPOInformation: params.JPO},
+		TxnID:       fmt.Sprintf("%s-%s-%d", command, params.OrderID, len(order.TransactionInfos.TransactionInfo)),
+		VResultCode: "N001000000000000",
+	})
+}
+
+// Answer the search request with the recorded orders of the requested order ID and service types
+func (server *Server) handleSearch(w http.ResponseWriter, r *http.Request) {
+	params, ok := server.decodeRequest(w, r)
+	if !ok {
+		return
+	}
+
+	server.mtx.Lock()
+	orderInfos := &veritrans.OrderInfos{}
+	for _, orderID := range server.orderIDs {
+		order := server.orders[orderID]
+		if params.SearchParam != nil && params.SearchParam.Common.OrderID != "" && params.SearchParam.Common.OrderID != orderID {
+			continue
+		}
+		if len(params.ServiceTypeCd) > 0 && !containsString(params.ServiceTypeCd, order.ServiceTypeCd) {
+			continue
+		}
+		orderInfo := *order
+		orderInfo.Index = len(orderInfos.OrderInfo)
+		orderInfo.TransactionInfos = &veritrans.TransactionInfos{
+			TransactionInfo: append([]veritrans.TransactionInfo{}, order.TransactionInfos.TransactionInfo...),
+		}
+		orderInfos.OrderInfo = append(orderInfos.OrderInfo, orderInfo)
+	}
+	server.mtx.Unlock()
+
+	writeResult(w, veritrans.Result{
+		VResultCode: "N001000000000000",
+		MStatus:     "success",
+		ServiceType: veritrans.PaymentServiceTypes[veritrans.Search],
+		OrderInfos:  orderInfos,
+	})
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
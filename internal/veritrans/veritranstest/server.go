@@ -0,0 +1,243 @@
+// Package veritranstest provides a fake veritrans payment api and the payment pages of the redirect services for the tests
+package veritranstest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/david1992121/veritrans-microservice/internal/veritrans"
+)
+
+// Server is a fake payment api which answers the requests of the payment services and the search,
+// and serves a fake ACS and payment pages which the customer is redirected to
+type Server struct {
+	*httptest.Server
+	MerchantCCID     string
+	MerchantPassword string
+
+	mtx             sync.Mutex
+	requests        []string
+	failures        map[string]bool
+	redirectionURIs map[string]string
+	paypalParams    map[string]veritrans.Params
+	foreignParams   map[string]veritrans.Params
+	carrierParams   map[string]veritrans.Params
+	orders          map[string]*veritrans.OrderInfo
+	orderIDs        []string
+}
+
+// NewServer starts the fake server
+func NewServer(merchantCCID string, merchantPassword string) *Server {
+	server := &Server{
+		MerchantCCID:     merchantCCID,
+		MerchantPassword: merchantPassword,
+		failures:         map[string]bool{},
+		redirectionURIs:  map[string]string{},
+		paypalParams:     map[string]veritrans.Params{},
+		foreignParams:    map[string]veritrans.Params{},
+		carrierParams:    map[string]veritrans.Params{},
+		orders:           map[string]*veritrans.OrderInfo{},
+	}
+
+	m := http.NewServeMux()
+	m.HandleFunc("/Authorize/card", server.handleAuthorizeCard)
+	m.HandleFunc("/Capture/card", server.handleCardResult)
+	m.HandleFunc("/Cancel/card", server.handleCardResult)
+	m.HandleFunc("/ReAuthorize/card", server.handleReAuthorizeCard)
+	m.HandleFunc("/Authorize/cvs", server.handleAuthorizeCVS)
+	m.HandleFunc("/Cancel/cvs", server.handleCancelCVS)
+	m.HandleFunc("/Authorize/bank", server.handleAuthorizeBank)
+	m.HandleFunc("/Cancel/bank", server.handleCancelBank)
+	m.HandleFunc("/Authorize/mpi", server.handleAuthorizeMPI)
+	m.HandleFunc("/acs", server.handleACS)
+	m.HandleFunc("/Authorize/paypal", server.handleAuthorizePaypal)
+	m.HandleFunc("/Capture/paypal", server.handlePaypalResult)
+	m.HandleFunc("/Cancel/paypal", server.handlePaypalResult)
+	m.HandleFunc("/paypal/login", server.handlePaypalLogin)
+	m.HandleFunc("/Authorize/carrier", server.handleAuthorizeCarrier)
+	m.HandleFunc("/Capture/carrier", server.handleCarrierResult)
+	m.HandleFunc("/Cancel/carrier", server.handleCarrierResult)
+	m.HandleFunc("/carrier/pay", server.handleCarrierPay)
+	m.HandleFunc("/Authorize/em", server.handleAuthorizeEM)
+	m.HandleFunc("/Cancel/em", server.handleEMResult)
+	m.HandleFunc("/Refund/em", server.handleEMResult)
+	m.HandleFunc("/Authorize/saison", server.handleSaison)
+	m.HandleFunc("/Capture/saison", server.handleSaison)
+	m.HandleFunc("/Cancel/saison", server.handleSaison)
+	m.HandleFunc("/Search/search", server.handleSearch)
+	for _, serviceType := range []veritrans.PaymentServiceType{veritrans.Alipay, veritrans.UPop} {
+		service := veritrans.PaymentServiceTypes[serviceType]
+		m.HandleFunc("/Authorize/"+service, server.handleAuthorizeForeign(serviceType))
+		m.HandleFunc("/Refund/"+service, server.handleRefundForeign(serviceType))
+		m.HandleFunc("/"+service+"/pay", server.handleForeignPay(serviceType))
+	}
+	server.Server = httptest.NewServer(m)
+	return server
+}
+
+// Requests returns the kinds of the received api requests (e.g. Authorize/mpi)
+func (server *Server) Requests() []string {
+	server.mtx.Lock()
+	defer server.mtx.Unlock()
+	return append([]string{}, server.requests...)
+}
+
+// FailNext makes the next request of the kind (e.g. Authorize/card) fail with a veritrans system error
+func (server *Server) FailNext(kind string) {
+	server.mtx.Lock()
+	defer server.mtx.Unlock()
+	server.failures[kind] = true
+}
+
+// Decode the request and record its kind
+// The request is answered with the system error instead if its failure is requested by FailNext
+func (server *Server) decodeRequest(w http.ResponseWriter, r *http.Request) (*veritrans.Params, bool) {
+	var connectionParam veritrans.ConnectionParam
+	if err := json.NewDecoder(r.Body).Decode(&connectionParam); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return nil, false
+	}
+
+	server.mtx.Lock()
+	defer server.mtx.Unlock()
+	kind := strings.TrimPrefix(r.URL.Path, "/")
+	server.requests = append(server.requests, kind)
+	if server.failures[kind] {
+		delete(server.failures, kind)
+		writeResult(w, veritrans.Result{
+			VResultCode: "NG01000000000000",
+			MStatus:     "failure",
+			OrderID:     connectionParam.Params.OrderID,
+		})
+		return nil, false
+	}
+	return &connectionParam.Params, true
+}
+
+// Authorize the card of the token or the account, and record the order for the search
+func (server *Server) handleAuthorizeCard(w http.ResponseWriter, r *http.Request) {
+	params, ok := server.decodeRequest(w, r)
+	if !ok {
+		return
+	}
+	if params.PayNowIDParam == nil || (params.PayNowIDParam.Token == "" && params.PayNowIDParam.AccountParam == nil) {
+		writeResult(w, veritrans.Result{
+			VResultCode: "MA99000000000000",
+			MStatus:     "failure",
+			OrderID:     params.OrderID,
+		})
+		return
+	}
+	server.recordTransaction(params, veritrans.PayCard, veritrans.PaymentManagementModes[veritrans.MethodAuthorize], nil)
+
+	writeResult(w, veritrans.Result{
+		VResultCode: "A001000000000000",
+		MStatus:     "success",
+		OrderID:     params.OrderID,
+		ServiceType: veritrans.PaymentServiceTypes[veritrans.PayCard],
+		CustTxn:     "card-" + params.OrderID,
+		ReqAmount:   params.Amount,
+	})
+}
+
+// Answer the card capture and cancel, and record them for the search
+func (server *Server) handleCardResult(w http.ResponseWriter, r *http.Request) {
+	params, ok := server.decodeRequest(w, r)
+	if !ok {
+		return
+	}
+	command := strings.Split(strings.TrimPrefix(r.URL.Path, "/"), "/")[0]
+	server.recordTransaction(params, veritrans.PayCard, command, nil)
+
+	writeResult(w, veritrans.Result{
+		VResultCode: "A001000000000000",
+		MStatus:     "success",
+		OrderID:     params.OrderID,
+		ServiceType: veritrans.PaymentServiceTypes[veritrans.PayCard],
+		CustTxn:     strings.ToLower(command) + "-" + params.OrderID,
+		ReqAmount:   params.Amount,
+	})
+}
+
+// Authorize the card of the recorded original order again under the new order ID
+func (server *Server) handleReAuthorizeCard(w http.ResponseWriter, r *http.Request) {
+	params, ok := server.decodeRequest(w, r)
+	if !ok {
+		return
+	}
+
+	server.mtx.Lock()
+	_, found := server.orders[params.OriginalOrderID]
+	server.mtx.Unlock()
+	if !found {
+		writeResult(w, veritrans.Result{
+			VResultCode: "MA99000000000000",
+			MStatus:     "failure",
+			OrderID:     params.OrderID,
+		})
+		return
+	}
+	server.recordTransaction(params, veritrans.PayCard, veritrans.PaymentManagementModes[veritrans.MethodReAuthorize], nil)
+
+	writeResult(w, veritrans.Result{
+		VResultCode: "A001000000000000",
+		MStatus:     "success",
+		OrderID:     params.OrderID,
+		ServiceType: veritrans.PaymentServiceTypes[veritrans.PayCard],
+		CustTxn:     "reauthorize-" + params.OrderID,
+		ReqAmount:   params.Amount,
+	})
+}
+
+// Sign the callback values with the merchant password
+func (server *Server) sign(values url.Values) url.Values {
+	values.Del(veritrans.AuthInfoKey)
+	values.Set(veritrans.AuthInfoKey, authInfo(values, server.MerchantCCID, server.MerchantPassword))
+	return values
+}
+
+// Calculate the vAuthInfo as veritrans does, independently of the service which verifies it
+// It is the sha256 hex digest of the merchant ccid, the "key=value" pairs sorted by the key and joined by "&", and the password
+func authInfo(values url.Values, merchantCCID string, merchantPassword string) string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := []string{}
+	for _, key := range keys {
+		for _, value := range values[key] {
+			pairs = append(pairs, url.QueryEscape(key)+"="+url.QueryEscape(value))
+		}
+	}
+	sum := sha256.Sum256([]byte(merchantCCID + strings.Join(pairs, "&") + merchantPassword))
+	return hex.EncodeToString(sum[:])
+}
+
+// Redirect the customer to the url with the values added to its query
+func redirectWithValues(w http.ResponseWriter, r *http.Request, rawURL string, values url.Values) {
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	query := parsedURL.Query()
+	for key, value := range values {
+		query[key] = value
+	}
+	parsedURL.RawQuery = query.Encode()
+	http.Redirect(w, r, parsedURL.String(), http.StatusFound)
+}
+
+func writeResult(w http.ResponseWriter, result veritrans.Result) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(veritrans.ConnectionResponse{Result: result})
+}
@@ -0,0 +1,17 @@
+package veritranstest
+
+import (
+	"net/url"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestAuthInfo(t *testing.T) {
+	values := url.Values{"orderId": {"order-1"}, "mstatus": {"success"}}
+	assert.Equal(t, "ba7815d2f128a048c6ae82d7f24d2acb39828938d3f0c7491685452f940d1721", authInfo(values, "ccid", "password"))
+
+	server := &Server{MerchantCCID: "ccid", MerchantPassword: "password"}
+	signed := server.sign(url.Values{"orderId": {"order-1"}, "mstatus": {"success"}, "vAuthInfo": {"stale"}})
+	assert.Equal(t, "ba7815d2f128a048c6ae82d7f24d2acb39828938d3f0c7491685452f940d1721", signed.Get("vAuthInfo"))
+}
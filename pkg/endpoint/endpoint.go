@@ -10,41 +10,69 @@ import (
 
 // Set struct provides the endpoints
 type Set struct {
-	GetMDKTokenEndpoint   endpoint.Endpoint
-	CreateAccountEndpoint endpoint.Endpoint
-	UpdateAccountEndpoint endpoint.Endpoint
-	CreateCardEndpoint    endpoint.Endpoint
-	UpdateCardEndpoint    endpoint.Endpoint
-	DeleteCardEndpoint    endpoint.Endpoint
-	GetCardEndpoint       endpoint.Endpoint
-	AuthorizeEndpoint     endpoint.Endpoint
-	CancelEndpoint        endpoint.Endpoint
-	CaptureEndpoint       endpoint.Endpoint
+	GetMDKTokenEndpoint           endpoint.Endpoint
+	CreateAccountEndpoint         endpoint.Endpoint
+	UpdateAccountEndpoint         endpoint.Endpoint
+	GetAccountEndpoint            endpoint.Endpoint
+	DeleteAccountEndpoint         endpoint.Endpoint
+	RestoreAccountEndpoint        endpoint.Endpoint
+	CreateCardEndpoint            endpoint.Endpoint
+	UpdateCardEndpoint            endpoint.Endpoint
+	DeleteCardEndpoint            endpoint.Endpoint
+	GetCardEndpoint               endpoint.Endpoint
+	CreateRecurringChargeEndpoint endpoint.Endpoint
+	UpdateRecurringChargeEndpoint endpoint.Endpoint
+	DeleteRecurringChargeEndpoint endpoint.Endpoint
+	GetRecurringChargeEndpoint    endpoint.Endpoint
+	AuthorizeEndpoint             endpoint.Endpoint
+	CancelEndpoint                endpoint.Endpoint
+	CaptureEndpoint               endpoint.Endpoint
+	RefundEndpoint                endpoint.Endpoint
+	CompleteEndpoint              endpoint.Endpoint
+	PartialCaptureEndpoint        endpoint.Endpoint
+	PartialCancelEndpoint         endpoint.Endpoint
+	ReAuthorizeEndpoint           endpoint.Endpoint
+	PayWithTokenEndpoint          endpoint.Endpoint
+	SearchOrdersEndpoint          endpoint.Endpoint
 }
 
 // NewEndpointSet initializes the Set struct
 func NewEndpointSet(svc pkg.Service) Set {
 	return Set{
-		GetMDKTokenEndpoint:   MakeGetMDKTokenEndpoint(svc),
-		CreateAccountEndpoint: MakeCreateAccountEndpoint(svc),
-		UpdateAccountEndpoint: MakeUpdateAccountEndpoint(svc),
-		CreateCardEndpoint:    MakeCreateCardEndpoint(svc),
-		UpdateCardEndpoint:    MakeUpdateCardEndpoint(svc),
-		DeleteCardEndpoint:    MakeDeleteCardEndpoint(svc),
-		GetCardEndpoint:       MakeGetCardEndpoint(svc),
-		AuthorizeEndpoint:     MakeAuthorizeEndpoint(svc),
-		CancelEndpoint:        MakeCancelEndpoint(svc),
-		CaptureEndpoint:       MakeCaptureEndpoint(svc),
+		GetMDKTokenEndpoint:           MakeGetMDKTokenEndpoint(svc),
+		CreateAccountEndpoint:         MakeCreateAccountEndpoint(svc),
+		UpdateAccountEndpoint:         MakeUpdateAccountEndpoint(svc),
+		GetAccountEndpoint:            MakeGetAccountEndpoint(svc),
+		DeleteAccountEndpoint:         MakeDeleteAccountEndpoint(svc),
+		RestoreAccountEndpoint:        MakeRestoreAccountEndpoint(svc),
+		CreateCardEndpoint:            MakeCreateCardEndpoint(svc),
+		UpdateCardEndpoint:            MakeUpdateCardEndpoint(svc),
+		DeleteCardEndpoint:            MakeDeleteCardEndpoint(svc),
+		GetCardEndpoint:               MakeGetCardEndpoint(svc),
+		CreateRecurringChargeEndpoint: MakeCreateRecurringChargeEndpoint(svc),
+		UpdateRecurringChargeEndpoint: MakeUpdateRecurringChargeEndpoint(svc),
+		DeleteRecurringChargeEndpoint: MakeDeleteRecurringChargeEndpoint(svc),
+		GetRecurringChargeEndpoint:    MakeGetRecurringChargeEndpoint(svc),
+		AuthorizeEndpoint:             MakeAuthorizeEndpoint(svc),
+		CancelEndpoint:                MakeCancelEndpoint(svc),
+		CaptureEndpoint:               MakeCaptureEndpoint(svc),
+		RefundEndpoint:                MakeRefundEndpoint(svc),
+		CompleteEndpoint:              MakeCompleteEndpoint(svc),
+		PartialCaptureEndpoint:        MakePartialCaptureEndpoint(svc),
+		PartialCancelEndpoint:         MakePartialCancelEndpoint(svc),
+		ReAuthorizeEndpoint:           MakeReAuthorizeEndpoint(svc),
+		PayWithTokenEndpoint:          MakePayWithTokenEndpoint(svc),
+		SearchOrdersEndpoint:          MakeSearchOrdersEndpoint(svc),
 	}
 }
 
 // MakeGetMDKTokenEndpoint returns the endpoint for mdk token request
 func MakeGetMDKTokenEndpoint(svc pkg.Service) endpoint.Endpoint {
-	return func(_ context.Context, request interface{}) (interface{}, error) {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(veritrans.ClientCardInfo)
-		token, err := svc.GetMDKToken(&req)
+		token, err := svc.GetMDKToken(ctx, &req)
 		if err != nil {
-			return GetMDKTokenResponse{Token: "", Err: err.Error()}, nil
+			return GetMDKTokenResponse{Token: "", Err: err.Error(), err: err}, nil
 		}
 		return GetMDKTokenResponse{Token: token, Err: ""}, nil
 	}
@@ -52,11 +80,11 @@ func MakeGetMDKTokenEndpoint(svc pkg.Service) endpoint.Endpoint {
 
 // MakeCreateAccountEndpoint returns the endpoint for account create request
 func MakeCreateAccountEndpoint(svc pkg.Service) endpoint.Endpoint {
-	return func(_ context.Context, request interface{}) (interface{}, error) {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(veritrans.AccountParam)
-		account, err := svc.CreateAccount(&req)
+		account, err := svc.CreateAccount(ctx, &req)
 		if err != nil {
-			return AccountResponse{Account: nil, Err: err.Error()}, nil
+			return AccountResponse{Account: nil, Err: err.Error(), err: err}, nil
 		}
 		return AccountResponse{Account: account, Err: ""}, nil
 	}
@@ -64,11 +92,47 @@ func MakeCreateAccountEndpoint(svc pkg.Service) endpoint.Endpoint {
 
 // MakeUpdateAccountEndpoint returns the endpoint for acount update request
 func MakeUpdateAccountEndpoint(svc pkg.Service) endpoint.Endpoint {
-	return func(_ context.Context, request interface{}) (interface{}, error) {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(veritrans.AccountParam)
-		account, err := svc.UpdateAccount(&req)
+		account, err := svc.UpdateAccount(ctx, &req)
 		if err != nil {
-			return AccountResponse{Account: nil, Err: err.Error()}, nil
+			return AccountResponse{Account: nil, Err: err.Error(), err: err}, nil
+		}
+		return AccountResponse{Account: account, Err: ""}, nil
+	}
+}
+
+// MakeGetAccountEndpoint returns the endpoint for account get request
+func MakeGetAccountEndpoint(svc pkg.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(veritrans.AccountParam)
+		account, err := svc.GetAccount(ctx, &req)
+		if err != nil {
+			return AccountResponse{Account: nil, Err: err.Error(), err: err}, nil
+		}
+		return AccountResponse{Account: account, Err: ""}, nil
+	}
+}
+
+// MakeDeleteAccountEndpoint returns the endpoint for account delete request
+func MakeDeleteAccountEndpoint(svc pkg.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(veritrans.AccountParam)
+		account, err := svc.DeleteAccount(ctx, &req)
+		if err != nil {
+			return AccountResponse{Account: nil, Err: err.Error(), err: err}, nil
+		}
+		return AccountResponse{Account: account, Err: ""}, nil
+	}
+}
+
+// MakeRestoreAccountEndpoint returns the endpoint for account restore request
+func MakeRestoreAccountEndpoint(svc pkg.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(veritrans.AccountParam)
+		account, err := svc.RestoreAccount(ctx, &req)
+		if err != nil {
+			return AccountResponse{Account: nil, Err: err.Error(), err: err}, nil
 		}
 		return AccountResponse{Account: account, Err: ""}, nil
 	}
@@ -76,11 +140,11 @@ func MakeUpdateAccountEndpoint(svc pkg.Service) endpoint.Endpoint {
 
 // MakeCreateCardEndpoint returns the endpoint for acount update request
 func MakeCreateCardEndpoint(svc pkg.Service) endpoint.Endpoint {
-	return func(_ context.Context, request interface{}) (interface{}, error) {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(veritrans.AccountParam)
-		account, err := svc.CreateCard(&req)
+		account, err := svc.CreateCard(ctx, &req)
 		if err != nil {
-			return AccountResponse{Account: nil, Err: err.Error()}, nil
+			return AccountResponse{Account: nil, Err: err.Error(), err: err}, nil
 		}
 		return AccountResponse{Account: account, Err: ""}, nil
 	}
@@ -88,11 +152,11 @@ func MakeCreateCardEndpoint(svc pkg.Service) endpoint.Endpoint {
 
 // MakeUpdateCardEndpoint returns the endpoint for acount update request
 func MakeUpdateCardEndpoint(svc pkg.Service) endpoint.Endpoint {
-	return func(_ context.Context, request interface{}) (interface{}, error) {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(veritrans.AccountParam)
-		account, err := svc.UpdateCard(&req)
+		account, err := svc.UpdateCard(ctx, &req)
 		if err != nil {
-			return AccountResponse{Account: nil, Err: err.Error()}, nil
+			return AccountResponse{Account: nil, Err: err.Error(), err: err}, nil
 		}
 		return AccountResponse{Account: account, Err: ""}, nil
 	}
@@ -100,11 +164,11 @@ func MakeUpdateCardEndpoint(svc pkg.Service) endpoint.Endpoint {
 
 // MakeDeleteCardEndpoint returns the endpoint for acount update request
 func MakeDeleteCardEndpoint(svc pkg.Service) endpoint.Endpoint {
-	return func(_ context.Context, request interface{}) (interface{}, error) {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(veritrans.AccountParam)
-		account, err := svc.DeleteCard(&req)
+		account, err := svc.DeleteCard(ctx, &req)
 		if err != nil {
-			return AccountResponse{Account: nil, Err: err.Error()}, nil
+			return AccountResponse{Account: nil, Err: err.Error(), err: err}, nil
 		}
 		return AccountResponse{Account: account, Err: ""}, nil
 	}
@@ -112,48 +176,254 @@ func MakeDeleteCardEndpoint(svc pkg.Service) endpoint.Endpoint {
 
 // MakeGetCardEndpoint returns the endpoint for acount update request
 func MakeGetCardEndpoint(svc pkg.Service) endpoint.Endpoint {
-	return func(_ context.Context, request interface{}) (interface{}, error) {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(veritrans.AccountParam)
-		account, err := svc.GetCard(&req)
+		account, err := svc.GetCard(ctx, &req)
 		if err != nil {
-			return AccountResponse{Account: nil, Err: err.Error()}, nil
+			return AccountResponse{Account: nil, Err: err.Error(), err: err}, nil
 		}
 		return AccountResponse{Account: account, Err: ""}, nil
 	}
 }
 
+// MakeCreateRecurringChargeEndpoint returns the endpoint for recurring charge create request
+func MakeCreateRecurringChargeEndpoint(svc pkg.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(RecurringChargeRequest)
+		account, err := svc.CreateRecurringCharge(ctx, getRecurringChargeParams(&req))
+		return getRecurringChargeResponse(account, err), nil
+	}
+}
+
+// MakeUpdateRecurringChargeEndpoint returns the endpoint for recurring charge update request
+func MakeUpdateRecurringChargeEndpoint(svc pkg.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(RecurringChargeRequest)
+		account, err := svc.UpdateRecurringCharge(ctx, getRecurringChargeParams(&req))
+		return getRecurringChargeResponse(account, err), nil
+	}
+}
+
+// MakeDeleteRecurringChargeEndpoint returns the endpoint for recurring charge delete request
+func MakeDeleteRecurringChargeEndpoint(svc pkg.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(RecurringChargeRequest)
+		account, err := svc.DeleteRecurringCharge(ctx, getRecurringChargeParams(&req))
+		return getRecurringChargeResponse(account, err), nil
+	}
+}
+
+// MakeGetRecurringChargeEndpoint returns the endpoint for recurring charge get request
+func MakeGetRecurringChargeEndpoint(svc pkg.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(RecurringChargeRequest)
+		account, err := svc.GetRecurringCharge(ctx, getRecurringChargeParams(&req))
+		return getRecurringChargeResponse(account, err), nil
+	}
+}
+
 // MakeAuthorizeEndpoint returns the endpoint for payment authorization request
 func MakeAuthorizeEndpoint(svc pkg.Service) endpoint.Endpoint {
-	return func(_ context.Context, request interface{}) (interface{}, error) {
-		req := request.(veritrans.Params)
-		err := svc.Authorize(&req)
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(PaymentRequest)
+		serviceType, err := veritrans.GetPaymentServiceType(req.ServiceType)
+		if err != nil {
+			return PaymentResponse{Result: nil, Err: err.Error(), err: err}, nil
+		}
+		result, err := svc.Authorize(ctx, &req.Params, serviceType)
 		if err != nil {
-			return PaymentResponse{Err: err.Error()}, nil
+			return PaymentResponse{Result: nil, Err: err.Error(), err: err}, nil
 		}
-		return PaymentResponse{Err: ""}, nil
+		return PaymentResponse{Result: result, Err: ""}, nil
 	}
 }
 
 // MakeCancelEndpoint returns the endpoint for payment cancel request
 func MakeCancelEndpoint(svc pkg.Service) endpoint.Endpoint {
-	return func(_ context.Context, request interface{}) (interface{}, error) {
-		req := request.(veritrans.Params)
-		err := svc.Cancel(&req)
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(PaymentRequest)
+		serviceType, err := veritrans.GetPaymentServiceType(req.ServiceType)
 		if err != nil {
-			return PaymentResponse{Err: err.Error()}, nil
+			return PaymentResponse{Result: nil, Err: err.Error(), err: err}, nil
 		}
-		return PaymentResponse{Err: ""}, nil
+		result, err := svc.Cancel(ctx, &req.Params, serviceType)
+		if err != nil {
+			return PaymentResponse{Result: nil, Err: err.Error(), err: err}, nil
+		}
+		return PaymentResponse{Result: result, Err: ""}, nil
 	}
 }
 
-// MakeCaptureEndpoint returns the endpoint for payment cancel request
+// MakeCaptureEndpoint returns the endpoint for payment capture request
 func MakeCaptureEndpoint(svc pkg.Service) endpoint.Endpoint {
-	return func(_ context.Context, request interface{}) (interface{}, error) {
-		req := request.(veritrans.Params)
-		err := svc.Capture(&req)
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(PaymentRequest)
+		serviceType, err := veritrans.GetPaymentServiceType(req.ServiceType)
 		if err != nil {
-			return PaymentResponse{Err: err.Error()}, nil
+			return PaymentResponse{Result: nil, Err: err.Error(), err: err}, nil
 		}
-		return PaymentResponse{Err: ""}, nil
+		result, err := svc.Capture(ctx, &req.Params, serviceType)
+		if err != nil {
+			return PaymentResponse{Result: nil, Err: err.Error(), err: err}, nil
+		}
+		return PaymentResponse{Result: result, Err: ""}, nil
+	}
+}
+
+// MakeRefundEndpoint returns the endpoint for payment refund request
+func MakeRefundEndpoint(svc pkg.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(PaymentRequest)
+		serviceType, err := veritrans.GetPaymentServiceType(req.ServiceType)
+		if err != nil {
+			return PaymentResponse{Result: nil, Err: err.Error(), err: err}, nil
+		}
+		result, err := svc.Refund(ctx, &req.Params, serviceType)
+		if err != nil {
+			return PaymentResponse{Result: nil, Err: err.Error(), err: err}, nil
+		}
+		return PaymentResponse{Result: result, Err: ""}, nil
+	}
+}
+
+// MakeCompleteEndpoint returns the endpoint for the callback of the redirect payment
+func MakeCompleteEndpoint(svc pkg.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(CallbackRequest)
+		serviceType, err := veritrans.GetPaymentServiceType(req.ServiceType)
+		if err != nil {
+			return PaymentResponse{Result: nil, Err: err.Error(), err: err}, nil
+		}
+		result, err := svc.Complete(ctx, req.Values, serviceType)
+		if err != nil {
+			return PaymentResponse{Result: nil, Err: err.Error(), err: err}, nil
+		}
+		return PaymentResponse{Result: result, Err: ""}, nil
+	}
+}
+
+// MakePartialCaptureEndpoint returns the endpoint for partial payment capture request
+func MakePartialCaptureEndpoint(svc pkg.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(PaymentRequest)
+		serviceType, err := veritrans.GetPaymentServiceType(req.ServiceType)
+		if err != nil {
+			return PaymentResponse{Result: nil, Err: err.Error(), err: err}, nil
+		}
+		result, err := svc.PartialCapture(ctx, &req.Params, serviceType)
+		if err != nil {
+			return PaymentResponse{Result: nil, Err: err.Error(), err: err}, nil
+		}
+		return PaymentResponse{Result: result, Err: ""}, nil
+	}
+}
+
+// MakePartialCancelEndpoint returns the endpoint for partial payment refund request
+func MakePartialCancelEndpoint(svc pkg.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(PaymentRequest)
+		serviceType, err := veritrans.GetPaymentServiceType(req.ServiceType)
+		if err != nil {
+			return PaymentResponse{Result: nil, Err: err.Error(), err: err}, nil
+		}
+		result, err := svc.PartialCancel(ctx, &req.Params, serviceType)
+		if err != nil {
+			return PaymentResponse{Result: nil, Err: err.Error(), err: err}, nil
+		}
+		return PaymentResponse{Result: result, Err: ""}, nil
+	}
+}
+
+// MakeReAuthorizeEndpoint returns the endpoint for card re-authorization request
+func MakeReAuthorizeEndpoint(svc pkg.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(ReAuthorizeRequest)
+		result, err := svc.ReAuthorize(ctx, &req.Params, req.CancelOriginal)
+		if err != nil {
+			return PaymentResponse{Result: nil, Err: err.Error(), err: err}, nil
+		}
+		return PaymentResponse{Result: result, Err: ""}, nil
+	}
+}
+
+// MakePayWithTokenEndpoint returns the endpoint for card payment request with the MDK token
+func MakePayWithTokenEndpoint(svc pkg.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(TokenPaymentRequest)
+		param := req.Params()
+		result, err := svc.PayWithToken(ctx, &param)
+		if err != nil {
+			return PaymentResponse{Result: nil, Err: err.Error(), err: err}, nil
+		}
+		return PaymentResponse{Result: result, Err: ""}, nil
+	}
+}
+
+// MakeSearchOrdersEndpoint returns the endpoint for order search request
+func MakeSearchOrdersEndpoint(svc pkg.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(SearchRequest)
+		orderInfos, err := svc.SearchOrders(ctx, getSearchParams(&req))
+		if err != nil {
+			return SearchResponse{Orders: nil, Err: err.Error(), err: err}, nil
+		}
+		if orderInfos == nil {
+			return SearchResponse{Orders: []veritrans.OrderInfo{}, Err: ""}, nil
+		}
+		return SearchResponse{Orders: orderInfos.OrderInfo, Err: ""}, nil
+	}
+}
+
+// Get the veritrans search params from the search request
+func getSearchParams(req *SearchRequest) *veritrans.Params {
+	serviceTypes := req.ServiceTypes
+	if len(serviceTypes) == 0 {
+		serviceTypes = []string{veritrans.PaymentServiceTypes[veritrans.PayCard]}
+	}
+
+	param := &veritrans.Params{
+		ServiceTypeCd: serviceTypes,
+		NewerFlag:     req.NewerFlag,
+		SearchParam: &veritrans.SearchParam{
+			Common: veritrans.OrderParam{
+				OrderID: req.OrderID,
+			},
+		},
+	}
+	if req.From != "" || req.To != "" {
+		param.SearchParam.Common.TxnDatetime = &veritrans.DateRange{
+			From: req.From,
+			To:   req.To,
+		}
+	}
+	if req.AccountID != "" {
+		param.PayNowIDParam = &veritrans.PayNowIDParam{
+			AccountParam: &veritrans.AccountParam{
+				AccountID: req.AccountID,
+			},
+		}
+	}
+	return param
+}
+
+// Get the account parameters of the recurring charge request
+func getRecurringChargeParams(req *RecurringChargeRequest) *veritrans.AccountParam {
+	recurringChargeParam := req.RecurringChargeParam
+	return &veritrans.AccountParam{
+		AccountID:            req.AccountID,
+		RecurringChargeParam: &recurringChargeParam,
+	}
+}
+
+// Get the recurring charge response from the account
+func getRecurringChargeResponse(account *veritrans.Account, err error) RecurringChargeResponse {
+	if err != nil {
+		return RecurringChargeResponse{Err: err.Error(), err: err}
+	}
+	res := RecurringChargeResponse{RecurringCharges: []veritrans.RecurringCharge{}}
+	if account != nil {
+		res.AccountID = account.AccountID
+		res.RecurringCharges = append(res.RecurringCharges, account.RecurringCharge...)
 	}
+	return res
 }
@@ -1,6 +1,10 @@
 package endpoint
 
-import "github.com/david1992121/veritrans-microservice/internal/veritrans"
+import (
+	"net/url"
+
+	"github.com/david1992121/veritrans-microservice/internal/veritrans"
+)
 
 // GetMDKTokenRequest struct
 // veritrans.ClientCardInfo
@@ -9,8 +13,12 @@ import "github.com/david1992121/veritrans-microservice/internal/veritrans"
 type GetMDKTokenResponse struct {
 	Token string `json:"token"`
 	Err   string `json:"err,omitempty"`
+	err   error
 }
 
+// Failed implements endpoint.Failer
+func (r GetMDKTokenResponse) Failed() error { return r.err }
+
 // AccountRequest struct
 // veritrans.AccountParam
 
@@ -18,12 +26,102 @@ type GetMDKTokenResponse struct {
 type AccountResponse struct {
 	Account *veritrans.Account `json:"account,omitempty"`
 	Err     string             `json:"err"`
+	err     error
+}
+
+// Failed implements endpoint.Failer
+func (r AccountResponse) Failed() error { return r.err }
+
+// RecurringChargeRequest struct
+type RecurringChargeRequest struct {
+	AccountID            string                         `json:"accountId"`
+	RecurringChargeParam veritrans.RecurringChargeParam `json:"recurringChargeParam"`
+}
+
+// RecurringChargeResponse struct
+type RecurringChargeResponse struct {
+	AccountID        string                      `json:"accountId,omitempty"`
+	RecurringCharges []veritrans.RecurringCharge `json:"recurringCharges"`
+	Err              string                      `json:"err"`
+	err              error
 }
 
+// Failed implements endpoint.Failer
+func (r RecurringChargeResponse) Failed() error { return r.err }
+
 // PaymentRequest struct
-// veritrans.AccountParam
+// ServiceType selects the payment service (the card if empty), and the params vary by the service
+type PaymentRequest struct {
+	ServiceType string `json:"serviceType,omitempty"`
+	veritrans.Params
+}
+
+// ReAuthorizeRequest struct
+// CancelOriginal cancels the original order after the re-authorization succeeds
+type ReAuthorizeRequest struct {
+	CancelOriginal bool `json:"cancelOriginal,omitempty"`
+	veritrans.Params
+}
+
+// TokenPaymentRequest struct
+// Token is the MDK token of the card, and the card is saved to the account of AccountID when given
+type TokenPaymentRequest struct {
+	OrderID       string                   `json:"orderId"`
+	Amount        string                   `json:"amount"`
+	Token         string                   `json:"token"`
+	AccountID     string                   `json:"accountId,omitempty"`
+	WithCapture   string                   `json:"withCapture,omitempty"`
+	PaymentOption *veritrans.PaymentOption `json:"paymentOption,omitempty"`
+}
+
+// Params returns the card payment params of the token payment
+func (r TokenPaymentRequest) Params() veritrans.Params {
+	payNowIDParam := &veritrans.PayNowIDParam{Token: r.Token}
+	if r.AccountID != "" {
+		payNowIDParam.AccountParam = &veritrans.AccountParam{AccountID: r.AccountID}
+	}
+	return veritrans.Params{
+		OrderID:       r.OrderID,
+		Amount:        r.Amount,
+		WithCapture:   r.WithCapture,
+		PaymentOption: r.PaymentOption,
+		PayNowIDParam: payNowIDParam,
+	}
+}
 
 // PaymentResponse struct
 type PaymentResponse struct {
-	Err string `json:"err"`
+	Result *veritrans.Result `json:"result,omitempty"`
+	Err    string            `json:"err"`
+	err    error
+}
+
+// Failed implements endpoint.Failer
+func (r PaymentResponse) Failed() error { return r.err }
+
+// CallbackRequest struct
+// Values are the form values posted by the 3-D secure ACS or the query of the return redirect
+type CallbackRequest struct {
+	ServiceType string
+	Values      url.Values
+}
+
+// SearchRequest struct
+type SearchRequest struct {
+	OrderID      string   `json:"orderId,omitempty"`
+	AccountID    string   `json:"accountId,omitempty"`
+	From         string   `json:"from,omitempty"`
+	To           string   `json:"to,omitempty"`
+	ServiceTypes []string `json:"serviceTypes,omitempty"`
+	NewerFlag    string   `json:"newerFlag,omitempty"`
 }
+
+// SearchResponse struct
+type SearchResponse struct {
+	Orders []veritrans.OrderInfo `json:"orders"`
+	Err    string                `json:"err"`
+	err    error
+}
+
+// Failed implements endpoint.Failer
+func (r SearchResponse) Failed() error { return r.err }
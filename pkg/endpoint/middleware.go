@@ -0,0 +1,138 @@
+package endpoint
+
+import (
+	"context"
+	"errors"
+	"net/url"
+
+	"github.com/david1992121/veritrans-microservice/internal/veritrans"
+	"github.com/david1992121/veritrans-microservice/pkg"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/sony/gobreaker"
+	"golang.org/x/time/rate"
+)
+
+// ErrUpstreamUnavailable is returned while the circuit breaker of the upstream api is open
+var ErrUpstreamUnavailable = errors.New("upstream unavailable")
+
+// ErrRateLimited is returned when the requests exceed the rate limit
+var ErrRateLimited = errors.New("rate limit exceeded")
+
+// WithResilience wraps the endpoints with the rate limiter and the circuit breaker of each upstream api
+// The callbacks of the redirect payments are not wrapped, since the customer returning from the redirect must not be rejected
+func (s Set) WithResilience(config pkg.ResilienceConfig) Set {
+	limiter := RateLimiter(config)
+	tokenAPI := endpoint.Chain(limiter, CircuitBreaker("token", config))
+	accountAPI := endpoint.Chain(limiter, CircuitBreaker("account", config))
+	paymentAPI := endpoint.Chain(limiter, CircuitBreaker("payment", config))
+	searchAPI := endpoint.Chain(limiter, CircuitBreaker("search", config))
+
+	wrap(tokenAPI, &s.GetMDKTokenEndpoint)
+	wrap(accountAPI,
+		&s.CreateAccountEndpoint,
+		&s.UpdateAccountEndpoint,
+		&s.GetAccountEndpoint,
+		&s.DeleteAccountEndpoint,
+		&s.RestoreAccountEndpoint,
+		&s.CreateCardEndpoint,
+		&s.UpdateCardEndpoint,
+		&s.DeleteCardEndpoint,
+		&s.GetCardEndpoint,
+		&s.CreateRecurringChargeEndpoint,
+		&s.UpdateRecurringChargeEndpoint,
+		&s.DeleteRecurringChargeEndpoint,
+		&s.GetRecurringChargeEndpoint,
+	)
+	wrap(paymentAPI,
+		&s.AuthorizeEndpoint,
+		&s.CancelEndpoint,
+		&s.CaptureEndpoint,
+		&s.RefundEndpoint,
+		&s.PartialCaptureEndpoint,
+		&s.PartialCancelEndpoint,
+		&s.ReAuthorizeEndpoint,
+		&s.PayWithTokenEndpoint,
+	)
+	wrap(searchAPI, &s.SearchOrdersEndpoint)
+	return s
+}
+
+// Wrap the endpoints with the middleware
+func wrap(middleware endpoint.Middleware, endpoints ...*endpoint.Endpoint) {
+	for _, e := range endpoints {
+		*e = middleware(*e)
+	}
+}
+
+// RateLimiter returns a middleware which rejects the requests over the token bucket rate limit
+func RateLimiter(config pkg.ResilienceConfig) endpoint.Middleware {
+	if config.RateLimit <= 0 {
+		return func(next endpoint.Endpoint) endpoint.Endpoint { return next }
+	}
+
+	limiter := rate.NewLimiter(rate.Limit(config.RateLimit), config.RateBurst)
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			if !limiter.Allow() {
+				return nil, ErrRateLimited
+			}
+			return next(ctx, request)
+		}
+	}
+}
+
+// CircuitBreaker returns a middleware which fails fast while the upstream api is unavailable
+func CircuitBreaker(name string, config pkg.ResilienceConfig) endpoint.Middleware {
+	breaker := gobreaker.NewCircuitBreaker(gobreaker.Settings{
+		Name:        name,
+		MaxRequests: config.BreakerMaxRequests,
+		Interval:    config.BreakerInterval,
+		Timeout:     config.BreakerTimeout,
+		ReadyToTrip: func(counts gobreaker.Counts) bool {
+			return counts.ConsecutiveFailures >= config.BreakerFailures
+		},
+	})
+
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			var response interface{}
+			_, err := breaker.Execute(func() (interface{}, error) {
+				var err error
+				response, err = next(ctx, request)
+				if err != nil {
+					return nil, err
+				}
+				// the business errors are returned in the response and only the upstream failures are counted
+				if f, ok := response.(endpoint.Failer); ok && isUpstreamFailure(f.Failed()) {
+					return nil, f.Failed()
+				}
+				return nil, nil
+			})
+
+			if errors.Is(err, gobreaker.ErrOpenState) || errors.Is(err, gobreaker.ErrTooManyRequests) {
+				return nil, ErrUpstreamUnavailable
+			}
+			if response != nil {
+				return response, nil
+			}
+			return nil, err
+		}
+	}
+}
+
+// Check if the error is caused by the unavailable upstream api
+func isUpstreamFailure(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	var apiErr *veritrans.APIError
+	if errors.As(err, &apiErr) {
+		category := apiErr.Category()
+		return category == veritrans.CategorySystemError || category == veritrans.CategoryRetryable
+	}
+
+	var httpErr *veritrans.HTTPError
+	var urlErr *url.Error
+	return errors.As(err, &httpErr) || errors.As(err, &urlErr) || errors.Is(err, context.DeadlineExceeded)
+}
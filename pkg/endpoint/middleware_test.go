@@ -0,0 +1,57 @@
+package endpoint
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/david1992121/veritrans-microservice/internal/veritrans"
+	"github.com/david1992121/veritrans-microservice/pkg"
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	config := pkg.DefaultResilienceConfig()
+	config.BreakerFailures = 2
+	config.BreakerTimeout = time.Minute
+
+	var responseErr error
+	calls := 0
+	ep := CircuitBreaker("test", config)(func(context.Context, interface{}) (interface{}, error) {
+		calls++
+		return PaymentResponse{Err: responseErr.Error(), err: responseErr}, nil
+	})
+
+	// business errors do not open the breaker
+	responseErr = &veritrans.APIError{VResultCode: "AG33000000000000", MStatus: "failure"}
+	for i := 0; i < 3; i++ {
+		res, err := ep(context.Background(), nil)
+		assert.Nil(t, err)
+		assert.True(t, veritrans.IsCardDeclined(res.(PaymentResponse).Failed()))
+	}
+
+	// upstream failures open the breaker
+	responseErr = &veritrans.HTTPError{StatusCode: 503}
+	for i := 0; i < 2; i++ {
+		_, err := ep(context.Background(), nil)
+		assert.Nil(t, err)
+	}
+	_, err := ep(context.Background(), nil)
+	assert.Equal(t, ErrUpstreamUnavailable, err)
+	assert.Equal(t, 5, calls)
+}
+
+func TestRateLimiter(t *testing.T) {
+	config := pkg.DefaultResilienceConfig()
+	config.RateLimit = 0.001
+	config.RateBurst = 1
+
+	ep := RateLimiter(config)(func(context.Context, interface{}) (interface{}, error) {
+		return PaymentResponse{}, nil
+	})
+
+	_, err := ep(context.Background(), nil)
+	assert.Nil(t, err)
+	_, err = ep(context.Background(), nil)
+	assert.Equal(t, ErrRateLimited, err)
+}
@@ -0,0 +1,322 @@
+package pkg
+
+import (
+	"context"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/david1992121/veritrans-microservice/internal/veritrans"
+	"github.com/go-kit/kit/metrics"
+	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Metrics is a set of the service metrics
+// RequestCount and RequestLatency are labelled by the method, the payment service type and the error category
+// UpstreamLatency is labelled by the veritrans api and the http status
+type Metrics struct {
+	RequestCount    metrics.Counter
+	RequestLatency  metrics.Histogram
+	UpstreamLatency metrics.Histogram
+}
+
+// NewPrometheusMetrics initializes the service metrics and registers them to the registerer
+func NewPrometheusMetrics(registerer prometheus.Registerer) Metrics {
+	requestCount := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "veritrans",
+		Subsystem: "service",
+		Name:      "request_count",
+		Help:      "Number of requests received.",
+	}, []string{"method", "service_type", "category"})
+	requestLatency := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "veritrans",
+		Subsystem: "service",
+		Name:      "request_latency_seconds",
+		Help:      "Total duration of requests in seconds.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "service_type", "category"})
+	upstreamLatency := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "veritrans",
+		Subsystem: "upstream",
+		Name:      "request_latency_seconds",
+		Help:      "Duration of veritrans api requests in seconds.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"api", "status"})
+	registerer.MustRegister(requestCount, requestLatency, upstreamLatency)
+
+	return Metrics{
+		RequestCount:    kitprometheus.NewCounter(requestCount),
+		RequestLatency:  kitprometheus.NewHistogram(requestLatency),
+		UpstreamLatency: kitprometheus.NewHistogram(upstreamLatency),
+	}
+}
+
+var (
+	defaultMetrics     Metrics
+	defaultMetricsOnce sync.Once
+)
+
+// GetMetrics returns the service metrics registered to the default prometheus registerer
+// The metrics are registered only once, so that the http and grpc handlers share them
+func GetMetrics() Metrics {
+	defaultMetricsOnce.Do(func() {
+		defaultMetrics = NewPrometheusMetrics(prometheus.DefaultRegisterer)
+	})
+	return defaultMetrics
+}
+
+type instrumentingMiddleware struct {
+	metrics Metrics
+	next    Service
+}
+
+// NewInstrumentingMiddleware function
+func NewInstrumentingMiddleware(metrics Metrics, service Service) Service {
+	return instrumentingMiddleware{
+		metrics: metrics,
+		next:    service,
+	}
+}
+
+// Observe the upstream requests of the context
+func (mw instrumentingMiddleware) withObserver(ctx context.Context) context.Context {
+	return veritrans.WithRequestObserver(ctx, func(api string, status string, took time.Duration) {
+		mw.metrics.UpstreamLatency.With("api", api, "status", status).Observe(took.Seconds())
+	})
+}
+
+// Call the next service with the upstream observer, and record the request count and latency
+func (mw instrumentingMiddleware) instrument(ctx context.Context, method string, serviceType string, call func(ctx context.Context) error) error {
+	begin := time.Now()
+	err := call(mw.withObserver(ctx))
+
+	labels := []string{"method", method, "service_type", serviceType, "category", getCategory(err)}
+	mw.metrics.RequestCount.With(labels...).Add(1)
+	mw.metrics.RequestLatency.With(labels...).Observe(time.Since(begin).Seconds())
+	return err
+}
+
+// GetMDKToken function
+func (mw instrumentingMiddleware) GetMDKToken(ctx context.Context, cardInfo *veritrans.ClientCardInfo) (output string, err error) {
+	err = mw.instrument(ctx, "GetMDKToken", "", func(ctx context.Context) error {
+		output, err = mw.next.GetMDKToken(ctx, cardInfo)
+		return err
+	})
+	return
+}
+
+// CreateAccount function
+func (mw instrumentingMiddleware) CreateAccount(ctx context.Context, accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
+	err = mw.instrument(ctx, "CreateAccount", "", func(ctx context.Context) error {
+		account, err = mw.next.CreateAccount(ctx, accountParam)
+		return err
+	})
+	return
+}
+
+// UpdateAccount function
+func (mw instrumentingMiddleware) UpdateAccount(ctx context.Context, accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
+	err = mw.instrument(ctx, "UpdateAccount", "", func(ctx context.Context) error {
+		account, err = mw.next.UpdateAccount(ctx, accountParam)
+		return err
+	})
+	return
+}
+
+// GetAccount function
+func (mw instrumentingMiddleware) GetAccount(ctx context.Context, accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
+	err = mw.instrument(ctx, "GetAccount", "", func(ctx context.Context) error {
+		account, err = mw.next.GetAccount(ctx, accountParam)
+		return err
+	})
+	return
+}
+
+// DeleteAccount function
+func (mw instrumentingMiddleware) DeleteAccount(ctx context.Context, accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
+	err = mw.instrument(ctx, "DeleteAccount", "", func(ctx context.Context) error {
+		account, err = mw.next.DeleteAccount(ctx, accountParam)
+		return err
+	})
+	return
+}
+
+// RestoreAccount function
+func (mw instrumentingMiddleware) RestoreAccount(ctx context.Context, accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
+	err = mw.instrument(ctx, "RestoreAccount", "", func(ctx context.Context) error {
+		account, err = mw.next.RestoreAccount(ctx, accountParam)
+		return err
+	})
+	return
+}
+
+// CreateCard function
+func (mw instrumentingMiddleware) CreateCard(ctx context.Context, accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
+	err = mw.instrument(ctx, "CreateCard", "", func(ctx context.Context) error {
+		account, err = mw.next.CreateCard(ctx, accountParam)
+		return err
+	})
+	return
+}
+
+// UpdateCard function
+func (mw instrumentingMiddleware) UpdateCard(ctx context.Context, accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
+	err = mw.instrument(ctx, "UpdateCard", "", func(ctx context.Context) error {
+		account, err = mw.next.UpdateCard(ctx, accountParam)
+		return err
+	})
+	return
+}
+
+// DeleteCard function
+func (mw instrumentingMiddleware) DeleteCard(ctx context.Context, accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
+	err = mw.instrument(ctx, "DeleteCard", "", func(ctx context.Context) error {
+		account, err = mw.next.DeleteCard(ctx, accountParam)
+		return err
+	})
+	return
+}
+
+// GetCard function
+func (mw instrumentingMiddleware) GetCard(ctx context.Context, accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
+	err = mw.instrument(ctx, "GetCard", "", func(ctx context.Context) error {
+		account, err = mw.next.GetCard(ctx, accountParam)
+		return err
+	})
+	return
+}
+
+// CreateRecurringCharge function
+func (mw instrumentingMiddleware) CreateRecurringCharge(ctx context.Context, accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
+	err = mw.instrument(ctx, "CreateRecurringCharge", "", func(ctx context.Context) error {
+		account, err = mw.next.CreateRecurringCharge(ctx, accountParam)
+		return err
+	})
+	return
+}
+
+// UpdateRecurringCharge function
+func (mw instrumentingMiddleware) UpdateRecurringCharge(ctx context.Context, accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
+	err = mw.instrument(ctx, "UpdateRecurringCharge", "", func(ctx context.Context) error {
+		account, err = mw.next.UpdateRecurringCharge(ctx, accountParam)
+		return err
+	})
+	return
+}
+
+// DeleteRecurringCharge function
+func (mw instrumentingMiddleware) DeleteRecurringCharge(ctx context.Context, accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
+	err = mw.instrument(ctx, "DeleteRecurringCharge", "", func(ctx context.Context) error {
+		account, err = mw.next.DeleteRecurringCharge(ctx, accountParam)
+		return err
+	})
+	return
+}
+
+// GetRecurringCharge function
+func (mw instrumentingMiddleware) GetRecurringCharge(ctx context.Context, accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
+	err = mw.instrument(ctx, "GetRecurringCharge", "", func(ctx context.Context) error {
+		account, err = mw.next.GetRecurringCharge(ctx, accountParam)
+		return err
+	})
+	return
+}
+
+// Authorize function
+func (mw instrumentingMiddleware) Authorize(ctx context.Context, param *veritrans.Params, serviceType veritrans.PaymentServiceType) (result *veritrans.Result, err error) {
+	err = mw.instrument(ctx, "Authorize", serviceType.String(), func(ctx context.Context) error {
+		result, err = mw.next.Authorize(ctx, param, serviceType)
+		return err
+	})
+	return
+}
+
+// Cancel function
+func (mw instrumentingMiddleware) Cancel(ctx context.Context, param *veritrans.Params, serviceType veritrans.PaymentServiceType) (result *veritrans.Result, err error) {
+	err = mw.instrument(ctx, "Cancel", serviceType.String(), func(ctx context.Context) error {
+		result, err = mw.next.Cancel(ctx, param, serviceType)
+		return err
+	})
+	return
+}
+
+// Capture function
+func (mw instrumentingMiddleware) Capture(ctx context.Context, param *veritrans.Params, serviceType veritrans.PaymentServiceType) (result *veritrans.Result, err error) {
+	err = mw.instrument(ctx, "Capture", serviceType.String(), func(ctx context.Context) error {
+		result, err = mw.next.Capture(ctx, param, serviceType)
+		return err
+	})
+	return
+}
+
+// Refund function
+func (mw instrumentingMiddleware) Refund(ctx context.Context, param *veritrans.Params, serviceType veritrans.PaymentServiceType) (result *veritrans.Result, err error) {
+	err = mw.instrument(ctx, "Refund", serviceType.String(), func(ctx context.Context) error {
+		result, err = mw.next.Refund(ctx, param, serviceType)
+		return err
+	})
+	return
+}
+
+// Complete function
+func (mw instrumentingMiddleware) Complete(ctx context.Context, values url.Values, serviceType veritrans.PaymentServiceType) (result *veritrans.Result, err error) {
+	err = mw.instrument(ctx, "Complete", serviceType.String(), func(ctx context.Context) error {
+		result, err = mw.next.Complete(ctx, values, serviceType)
+		return err
+	})
+	return
+}
+
+// PartialCapture function
+func (mw instrumentingMiddleware) PartialCapture(ctx context.Context, param *veritrans.Params, serviceType veritrans.PaymentServiceType) (result *veritrans.Result, err error) {
+	err = mw.instrument(ctx, "PartialCapture", serviceType.String(), func(ctx context.Context) error {
+		result, err = mw.next.PartialCapture(ctx, param, serviceType)
+		return err
+	})
+	return
+}
+
+// PartialCancel function
+func (mw instrumentingMiddleware) PartialCancel(ctx context.Context, param *veritrans.Params, serviceType veritrans.PaymentServiceType) (result *veritrans.Result, err error) {
+	err = mw.instrument(ctx, "PartialCancel", serviceType.String(), func(ctx context.Context) error {
+		result, err = mw.next.PartialCancel(ctx, param, serviceType)
+		return err
+	})
+	return
+}
+
+// ReAuthorize function
+func (mw instrumentingMiddleware) ReAuthorize(ctx context.Context, param *veritrans.Params, cancelOriginal bool) (result *veritrans.Result, err error) {
+	err = mw.instrument(ctx, "ReAuthorize", veritrans.PayCard.String(), func(ctx context.Context) error {
+		result, err = mw.next.ReAuthorize(ctx, param, cancelOriginal)
+		return err
+	})
+	return
+}
+
+// PayWithToken function
+func (mw instrumentingMiddleware) PayWithToken(ctx context.Context, param *veritrans.Params) (result *veritrans.Result, err error) {
+	err = mw.instrument(ctx, "PayWithToken", veritrans.PayCard.String(), func(ctx context.Context) error {
+		result, err = mw.next.PayWithToken(ctx, param)
+		return err
+	})
+	return
+}
+
+// SearchOrders function
+func (mw instrumentingMiddleware) SearchOrders(ctx context.Context, param *veritrans.Params) (orderInfos *veritrans.OrderInfos, err error) {
+	err = mw.instrument(ctx, "SearchOrders", veritrans.Search.String(), func(ctx context.Context) error {
+		orderInfos, err = mw.next.SearchOrders(ctx, param)
+		return err
+	})
+	return
+}
+
+// Get the error category label of the result
+func getCategory(err error) string {
+	if err == nil {
+		return "success"
+	}
+	return veritrans.GetErrorCategory(err).String()
+}
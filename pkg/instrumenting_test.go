@@ -0,0 +1,45 @@
+package pkg
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/david1992121/veritrans-microservice/internal/veritrans"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+// declinedService declines every authorization
+type declinedService struct {
+	Service
+}
+
+func (declinedService) Authorize(ctx context.Context, param *veritrans.Params, serviceType veritrans.PaymentServiceType) (*veritrans.Result, error) {
+	return nil, &veritrans.APIError{VResultCode: "AG33000000000000", MStatus: "failure", Kind: "Authorize/card"}
+}
+
+func TestInstrumentingMiddleware(t *testing.T) {
+	ctx := context.Background()
+	registry := prometheus.NewRegistry()
+	metrics := NewPrometheusMetrics(registry)
+
+	_, err := NewInstrumentingMiddleware(metrics, stubService{}).Authorize(ctx, &veritrans.Params{}, veritrans.PayCard)
+	assert.NoError(t, err)
+	_, err = NewInstrumentingMiddleware(metrics, declinedService{}).Authorize(ctx, &veritrans.Params{}, veritrans.PayCard)
+	assert.Error(t, err)
+	_, err = NewInstrumentingMiddleware(metrics, declinedService{}).Authorize(ctx, &veritrans.Params{}, veritrans.PayCard)
+	assert.Error(t, err)
+	_, err = NewInstrumentingMiddleware(metrics, stubService{}).Authorize(ctx, &veritrans.Params{}, veritrans.CVS)
+	assert.NoError(t, err)
+
+	expected := `
+# HELP veritrans_service_request_count Number of requests received.
+# TYPE veritrans_service_request_count counter
+veritrans_service_request_count{category="card_declined",method="Authorize",service_type="card"} 2
+veritrans_service_request_count{category="success",method="Authorize",service_type="card"} 1
+veritrans_service_request_count{category="success",method="Authorize",service_type="cvs"} 1
+`
+	assert.NoError(t, testutil.GatherAndCompare(registry, strings.NewReader(expected), "veritrans_service_request_count"))
+}
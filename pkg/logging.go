@@ -1,7 +1,8 @@
 package pkg
 
 import (
-	"encoding/json"
+	"context"
+	"net/url"
 	"time"
 
 	"github.com/david1992121/veritrans-microservice/internal/veritrans"
@@ -22,162 +23,241 @@ func NewLoggingMiddleware(logger log.Logger, service Service) Service {
 }
 
 // GetMDKToken function
-func (mw loggingMiddleware) GetMDKToken(cardInfo *veritrans.ClientCardInfo) (output string, err error) {
-	cardString, _ := json.Marshal(cardInfo)
-	defer func(begin time.Time) {
-		mw.logger.Log(
-			"method", "GetMDKToken",
-			"input", cardString,
-			"output", output,
-			"err", err,
-			"took", time.Since(begin),
-		)
-	}(time.Now())
-
-	output, err = mw.next.GetMDKToken(cardInfo)
+func (mw loggingMiddleware) GetMDKToken(ctx context.Context, cardInfo *veritrans.ClientCardInfo) (output string, err error) {
+	err = mw.log("GetMDKToken", cardInfo, func() (interface{}, error) {
+		output, err = mw.next.GetMDKToken(ctx, cardInfo)
+		return output, err
+	})
 	return
 }
 
 // CreateAccount function
-func (mw loggingMiddleware) CreateAccount(accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
-	inputString, _ := json.Marshal(accountParam)
-	defer func(begin time.Time) {
-		mw.logger.Log(
-			"method", "CreateAccount",
-			"input", inputString,
-			"err", err,
-			"took", time.Since(begin),
-		)
-	}(time.Now())
-
-	account, err = mw.next.CreateAccount(accountParam)
+func (mw loggingMiddleware) CreateAccount(ctx context.Context, accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
+	err = mw.log("CreateAccount", accountParam, func() (interface{}, error) {
+		account, err = mw.next.CreateAccount(ctx, accountParam)
+		return account, err
+	})
 	return
 }
 
 // UpdateAccount function
-func (mw loggingMiddleware) UpdateAccount(accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
-	inputString, _ := json.Marshal(accountParam)
-	defer func(begin time.Time) {
-		mw.logger.Log(
-			"method", "UpdateAccount",
-			"input", inputString,
-			"err", err,
-			"took", time.Since(begin),
-		)
-	}(time.Now())
+func (mw loggingMiddleware) UpdateAccount(ctx context.Context, accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
+	err = mw.log("UpdateAccount", accountParam, func() (interface{}, error) {
+		account, err = mw.next.UpdateAccount(ctx, accountParam)
+		return account, err
+	})
+	return
+}
 
-	account, err = mw.next.UpdateAccount(accountParam)
+// GetAccount function
+func (mw loggingMiddleware) GetAccount(ctx context.Context, accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
+	err = mw.log("GetAccount", accountParam, func() (interface{}, error) {
+		account, err = mw.next.GetAccount(ctx, accountParam)
+		return account, err
+	})
 	return
 }
 
-// CreateCard function
-func (mw loggingMiddleware) CreateCard(accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
-	inputString, _ := json.Marshal(accountParam)
-	defer func(begin time.Time) {
-		mw.logger.Log(
-			"method", "CreateCard",
-			"input", inputString,
-			"err", err,
-			"took", time.Since(begin),
-		)
-	}(time.Now())
+// DeleteAccount function
+func (mw loggingMiddleware) DeleteAccount(ctx context.Context, accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
+	err = mw.log("DeleteAccount", accountParam, func() (interface{}, error) {
+		account, err = mw.next.DeleteAccount(ctx, accountParam)
+		return account, err
+	})
+	return
+}
 
-	account, err = mw.next.CreateCard(accountParam)
+// RestoreAccount function
+func (mw loggingMiddleware) RestoreAccount(ctx context.Context, accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
+	err = mw.log("RestoreAccount", accountParam, func() (interface{}, error) {
+		account, err = mw.next.RestoreAccount(ctx, accountParam)
+		return account, err
+	})
 	return
 }
 
-// UpdateCard function
-func (mw loggingMiddleware) UpdateCard(accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
-	inputString, _ := json.Marshal(accountParam)
-	defer func(begin time.Time) {
-		mw.logger.Log(
-			"method", "UpdateCard",
-			"input", inputString,
-			"err", err,
-			"took", time.Since(begin),
-		)
-	}(time.Now())
+// CreateCard function
+func (mw loggingMiddleware) CreateCard(ctx context.Context, accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
+	err = mw.log("CreateCard", accountParam, func() (interface{}, error) {
+		account, err = mw.next.CreateCard(ctx, accountParam)
+		return account, err
+	})
+	return
+}
 
-	account, err = mw.next.UpdateCard(accountParam)
+// UpdateCard function
+func (mw loggingMiddleware) UpdateCard(ctx context.Context, accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
+	err = mw.log("UpdateCard", accountParam, func() (interface{}, error) {
+		account, err = mw.next.UpdateCard(ctx, accountParam)
+		return account, err
+	})
 	return
 }
 
 // DeleteCard function
-func (mw loggingMiddleware) DeleteCard(accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
-	inputString, _ := json.Marshal(accountParam)
-	defer func(begin time.Time) {
-		mw.logger.Log(
-			"method", "DeleteCard",
-			"input", inputString,
-			"err", err,
-			"took", time.Since(begin),
-		)
-	}(time.Now())
-
-	account, err = mw.next.DeleteCard(accountParam)
+func (mw loggingMiddleware) DeleteCard(ctx context.Context, accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
+	err = mw.log("DeleteCard", accountParam, func() (interface{}, error) {
+		account, err = mw.next.DeleteCard(ctx, accountParam)
+		return account, err
+	})
 	return
 }
 
 // GetCard function
-func (mw loggingMiddleware) GetCard(accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
-	inputString, _ := json.Marshal(accountParam)
-	defer func(begin time.Time) {
-		mw.logger.Log(
-			"method", "GetCard",
-			"input", inputString,
-			"err", err,
-			"took", time.Since(begin),
-		)
-	}(time.Now())
+func (mw loggingMiddleware) GetCard(ctx context.Context, accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
+	err = mw.log("GetCard", accountParam, func() (interface{}, error) {
+		account, err = mw.next.GetCard(ctx, accountParam)
+		return account, err
+	})
+	return
+}
 
-	account, err = mw.next.GetCard(accountParam)
+// CreateRecurringCharge function
+func (mw loggingMiddleware) CreateRecurringCharge(ctx context.Context, accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
+	err = mw.log("CreateRecurringCharge", accountParam, func() (interface{}, error) {
+		account, err = mw.next.CreateRecurringCharge(ctx, accountParam)
+		return account, err
+	})
 	return
 }
 
-// Authorize function
-func (mw loggingMiddleware) Authorize(param *veritrans.Params) (err error) {
-	inputString, _ := json.Marshal(param)
-	defer func(begin time.Time) {
-		mw.logger.Log(
-			"method", "Authorize",
-			"input", inputString,
-			"err", err,
-			"took", time.Since(begin),
-		)
-	}(time.Now())
+// UpdateRecurringCharge function
+func (mw loggingMiddleware) UpdateRecurringCharge(ctx context.Context, accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
+	err = mw.log("UpdateRecurringCharge", accountParam, func() (interface{}, error) {
+		account, err = mw.next.UpdateRecurringCharge(ctx, accountParam)
+		return account, err
+	})
+	return
+}
 
-	err = mw.next.Authorize(param)
+// DeleteRecurringCharge function
+func (mw loggingMiddleware) DeleteRecurringCharge(ctx context.Context, accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
+	err = mw.log("DeleteRecurringCharge", accountParam, func() (interface{}, error) {
+		account, err = mw.next.DeleteRecurringCharge(ctx, accountParam)
+		return account, err
+	})
 	return
 }
 
-// Cancel function
-func (mw loggingMiddleware) Cancel(param *veritrans.Params) (err error) {
-	inputString, _ := json.Marshal(param)
-	defer func(begin time.Time) {
-		mw.logger.Log(
-			"method", "Cancel",
-			"input", inputString,
-			"err", err,
-			"took", time.Since(begin),
-		)
-	}(time.Now())
+// GetRecurringCharge function
+func (mw loggingMiddleware) GetRecurringCharge(ctx context.Context, accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
+	err = mw.log("GetRecurringCharge", accountParam, func() (interface{}, error) {
+		account, err = mw.next.GetRecurringCharge(ctx, accountParam)
+		return account, err
+	})
+	return
+}
 
-	err = mw.next.Cancel(param)
+// Authorize function
+func (mw loggingMiddleware) Authorize(ctx context.Context, param *veritrans.Params, serviceType veritrans.PaymentServiceType) (result *veritrans.Result, err error) {
+	err = mw.log("Authorize", param, func() (interface{}, error) {
+		result, err = mw.next.Authorize(ctx, param, serviceType)
+		return result, err
+	}, "serviceType", serviceType.String())
+	return
+}
+
+// Cancel function
+func (mw loggingMiddleware) Cancel(ctx context.Context, param *veritrans.Params, serviceType veritrans.PaymentServiceType) (result *veritrans.Result, err error) {
+	err = mw.log("Cancel", param, func() (interface{}, error) {
+		result, err = mw.next.Cancel(ctx, param, serviceType)
+		return result, err
+	}, "serviceType", serviceType.String())
 	return
 }
 
 // Capture function
-func (mw loggingMiddleware) Capture(param *veritrans.Params) (err error) {
-	inputString, _ := json.Marshal(param)
-	defer func(begin time.Time) {
-		mw.logger.Log(
-			"method", "Capture",
-			"input", inputString,
-			"err", err,
-			"took", time.Since(begin),
-		)
-	}(time.Now())
-
-	err = mw.next.Capture(param)
+func (mw loggingMiddleware) Capture(ctx context.Context, param *veritrans.Params, serviceType veritrans.PaymentServiceType) (result *veritrans.Result, err error) {
+	err = mw.log("Capture", param, func() (interface{}, error) {
+		result, err = mw.next.Capture(ctx, param, serviceType)
+		return result, err
+	}, "serviceType", serviceType.String())
+	return
+}
+
+// Refund function
+func (mw loggingMiddleware) Refund(ctx context.Context, param *veritrans.Params, serviceType veritrans.PaymentServiceType) (result *veritrans.Result, err error) {
+	err = mw.log("Refund", param, func() (interface{}, error) {
+		result, err = mw.next.Refund(ctx, param, serviceType)
+		return result, err
+	}, "serviceType", serviceType.String())
+	return
+}
+
+// Complete function
+func (mw loggingMiddleware) Complete(ctx context.Context, values url.Values, serviceType veritrans.PaymentServiceType) (result *veritrans.Result, err error) {
+	err = mw.log("Complete", values, func() (interface{}, error) {
+		result, err = mw.next.Complete(ctx, values, serviceType)
+		return result, err
+	}, "serviceType", serviceType.String())
+	return
+}
+
+// PartialCapture function
+func (mw loggingMiddleware) PartialCapture(ctx context.Context, param *veritrans.Params, serviceType veritrans.PaymentServiceType) (result *veritrans.Result, err error) {
+	err = mw.log("PartialCapture", param, func() (interface{}, error) {
+		result, err = mw.next.PartialCapture(ctx, param, serviceType)
+		return result, err
+	}, "serviceType", serviceType.String())
+	return
+}
+
+// PartialCancel function
+func (mw loggingMiddleware) PartialCancel(ctx context.Context, param *veritrans.Params, serviceType veritrans.PaymentServiceType) (result *veritrans.Result, err error) {
+	err = mw.log("PartialCancel", param, func() (interface{}, error) {
+		result, err = mw.next.PartialCancel(ctx, param, serviceType)
+		return result, err
+	}, "serviceType", serviceType.String())
+	return
+}
+
+// ReAuthorize function
+func (mw loggingMiddleware) ReAuthorize(ctx context.Context, param *veritrans.Params, cancelOriginal bool) (result *veritrans.Result, err error) {
+	err = mw.log("ReAuthorize", param, func() (interface{}, error) {
+		result, err = mw.next.ReAuthorize(ctx, param, cancelOriginal)
+		return result, err
+	}, "cancelOriginal", cancelOriginal)
 	return
 }
+
+// PayWithToken function
+func (mw loggingMiddleware) PayWithToken(ctx context.Context, param *veritrans.Params) (result *veritrans.Result, err error) {
+	err = mw.log("PayWithToken", param, func() (interface{}, error) {
+		result, err = mw.next.PayWithToken(ctx, param)
+		return result, err
+	})
+	return
+}
+
+// SearchOrders function
+func (mw loggingMiddleware) SearchOrders(ctx context.Context, param *veritrans.Params) (orderInfos *veritrans.OrderInfos, err error) {
+	err = mw.log("SearchOrders", param, func() (interface{}, error) {
+		orderInfos, err = mw.next.SearchOrders(ctx, param)
+		return orderInfos, err
+	})
+	return
+}
+
+// Log the call of the next service with the redacted input, the error and the time taken
+// The closure returns the output of the service, and the result code is logged for the payments
+func (mw loggingMiddleware) log(method string, input interface{}, call func() (interface{}, error), keyvals ...interface{}) error {
+	inputString := redact(input)
+	begin := time.Now()
+	output, err := call()
+
+	keyvals = append([]interface{}{"method", method}, keyvals...)
+	keyvals = append(keyvals, "input", inputString)
+	if result, ok := output.(*veritrans.Result); ok {
+		keyvals = append(keyvals, "result", getResultCode(result))
+	}
+	mw.logger.Log(append(keyvals, "err", err, "took", time.Since(begin))...)
+	return err
+}
+
+// Get the result code of the veritrans result
+func getResultCode(result *veritrans.Result) string {
+	if result == nil {
+		return ""
+	}
+	return result.VResultCode
+}
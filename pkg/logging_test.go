@@ -0,0 +1,84 @@
+package pkg
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/david1992121/veritrans-microservice/internal/veritrans"
+	"github.com/go-kit/log"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testCardNumber   = "4111111111111111"
+	testMaskedNumber = "411111******1111"
+	testSecurityCode = "9876"
+	testToken        = "tok-7f3a9c1e2b"
+)
+
+// stubService returns fixed results for the logged methods
+type stubService struct {
+	Service
+}
+
+func (stubService) GetMDKToken(ctx context.Context, cardInfo *veritrans.ClientCardInfo) (string, error) {
+	return testToken, nil
+}
+
+func (stubService) CreateCard(ctx context.Context, accountParam *veritrans.AccountParam) (*veritrans.Account, error) {
+	return &veritrans.Account{}, nil
+}
+
+func (stubService) Authorize(ctx context.Context, param *veritrans.Params, serviceType veritrans.PaymentServiceType) (*veritrans.Result, error) {
+	return &veritrans.Result{VResultCode: "A001000000000000", MStatus: "success"}, nil
+}
+
+func TestMaskCardNumber(t *testing.T) {
+	assert.Equal(t, testMaskedNumber, MaskCardNumber(testCardNumber))
+	assert.Equal(t, "411111********1111", MaskCardNumber("411111********1111"))
+	assert.Equal(t, "****", MaskCardNumber("4111"))
+	assert.Equal(t, "", MaskCardNumber(""))
+}
+
+func TestLoggingRedaction(t *testing.T) {
+	ctx := context.Background()
+	var buf bytes.Buffer
+	service := NewLoggingMiddleware(log.NewLogfmtLogger(&buf), stubService{})
+
+	_, err := service.GetMDKToken(ctx, &veritrans.ClientCardInfo{
+		CardNumber:   testCardNumber,
+		CardExpire:   "12/30",
+		SecurityCode: testSecurityCode,
+	})
+	assert.NoError(t, err)
+
+	_, err = service.CreateCard(ctx, &veritrans.AccountParam{
+		AccountID: "account",
+		CardParam: &veritrans.CardParam{
+			CardNumber: testCardNumber,
+			CardExpire: "12/30",
+			Token:      testToken,
+		},
+	})
+	assert.NoError(t, err)
+
+	_, err = service.Authorize(ctx, &veritrans.Params{
+		OrderID: "order",
+		Amount:  "100",
+		PayNowIDParam: &veritrans.PayNowIDParam{
+			Token: testToken,
+		},
+	}, veritrans.PayCard)
+	assert.NoError(t, err)
+
+	logs := buf.String()
+	assert.Contains(t, logs, "serviceType=card")
+	assert.Contains(t, logs, "result=A001000000000000")
+	assert.Contains(t, logs, testMaskedNumber)
+	assert.NotContains(t, logs, testCardNumber)
+	assert.NotContains(t, logs, testSecurityCode)
+	assert.NotContains(t, logs, testToken)
+	assert.NotContains(t, logs, "security_code")
+	assert.NotContains(t, logs, "token")
+}
@@ -0,0 +1,74 @@
+package pkg
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// maskedKeys are the json keys of the card numbers which are masked to the first 6 and last 4 digits
+var maskedKeys = map[string]bool{
+	"card_number":     true,
+	"cardNumber":      true,
+	"req_card_number": true,
+}
+
+// droppedKeys are the json keys of the secrets which are never logged
+var droppedKeys = map[string]bool{
+	"security_code":    true,
+	"securityCode":     true,
+	"token":            true,
+	"token_api_key":    true,
+	"merchantPassword": true,
+	"authHash":         true,
+	"vAuthInfo":        true,
+}
+
+// MaskCardNumber masks the card number except the first 6 and last 4 digits
+func MaskCardNumber(cardNumber string) string {
+	if len(cardNumber) < 14 {
+		return strings.Repeat("*", len(cardNumber))
+	}
+	return cardNumber[:6] + strings.Repeat("*", len(cardNumber)-10) + cardNumber[len(cardNumber)-4:]
+}
+
+// Get the json string of the value with the card data and secrets redacted
+func redact(value interface{}) string {
+	valueJSON, err := json.Marshal(value)
+	if err != nil {
+		return ""
+	}
+
+	var fields interface{}
+	if err := json.Unmarshal(valueJSON, &fields); err != nil {
+		return ""
+	}
+
+	redactedJSON, err := json.Marshal(redactFields(fields))
+	if err != nil {
+		return ""
+	}
+	return string(redactedJSON)
+}
+
+// Redact the decoded json fields recursively
+func redactFields(fields interface{}) interface{} {
+	switch typedFields := fields.(type) {
+	case map[string]interface{}:
+		for key, item := range typedFields {
+			if droppedKeys[key] {
+				delete(typedFields, key)
+				continue
+			}
+			if cardNumber, ok := item.(string); ok && maskedKeys[key] {
+				typedFields[key] = MaskCardNumber(cardNumber)
+				continue
+			}
+			typedFields[key] = redactFields(item)
+		}
+	case []interface{}:
+		for i, item := range typedFields {
+			typedFields[i] = redactFields(item)
+		}
+	}
+	return fields
+}
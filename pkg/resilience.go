@@ -0,0 +1,45 @@
+package pkg
+
+import (
+	"time"
+)
+
+// ResilienceConfig is a configuration of the circuit breakers and the rate limiter
+// BreakerMaxRequests is the number of requests allowed while the breaker is half-open
+// BreakerInterval is the cyclic period of clearing the failure counts while closed
+// BreakerTimeout is the period of failing fast after the breaker opens
+// BreakerFailures is the number of consecutive upstream failures which opens the breaker
+// RateLimit is the number of requests per second allowed (0 disables the limiter)
+// RateBurst is the size of the token bucket
+type ResilienceConfig struct {
+	BreakerMaxRequests uint32
+	BreakerInterval    time.Duration
+	BreakerTimeout     time.Duration
+	BreakerFailures    uint32
+	RateLimit          float64
+	RateBurst          int
+}
+
+// DefaultResilienceConfig returns the default resilience configuration
+func DefaultResilienceConfig() ResilienceConfig {
+	return ResilienceConfig{
+		BreakerMaxRequests: 1,
+		BreakerInterval:    60 * time.Second,
+		BreakerTimeout:     30 * time.Second,
+		BreakerFailures:    5,
+		RateLimit:          100,
+		RateBurst:          100,
+	}
+}
+
+// GetResilienceConfig initializes the resilience configuration from the environment
+func GetResilienceConfig() ResilienceConfig {
+	config := DefaultResilienceConfig()
+	config.BreakerMaxRequests = uint32(envInt("BREAKER_MAX_REQUESTS", int(config.BreakerMaxRequests)))
+	config.BreakerInterval = envDuration("BREAKER_INTERVAL", config.BreakerInterval)
+	config.BreakerTimeout = envDuration("BREAKER_TIMEOUT", config.BreakerTimeout)
+	config.BreakerFailures = uint32(envInt("BREAKER_FAILURES", int(config.BreakerFailures)))
+	config.RateLimit = envFloat("RATE_LIMIT", config.RateLimit)
+	config.RateBurst = envInt("RATE_BURST", config.RateBurst)
+	return config
+}
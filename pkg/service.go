@@ -1,29 +1,60 @@
 package pkg
 
 import (
+	"context"
+	"net/url"
+
 	"github.com/david1992121/veritrans-microservice/internal/veritrans"
 )
 
 // Service of the veritrans payment
 type Service interface {
 	// GetMDKToken function gets the MDK token from card information
-	GetMDKToken(cardInfo *veritrans.ClientCardInfo) (string, error)
+	GetMDKToken(ctx context.Context, cardInfo *veritrans.ClientCardInfo) (string, error)
 	// CreateAccount function creates a veritrans account
-	CreateAccount(accountParam *veritrans.AccountParam) (*veritrans.Account, error)
+	CreateAccount(ctx context.Context, accountParam *veritrans.AccountParam) (*veritrans.Account, error)
 	// UpdateAccount function updates the veritrans account
-	UpdateAccount(accountParam *veritrans.AccountParam) (*veritrans.Account, error)
+	UpdateAccount(ctx context.Context, accountParam *veritrans.AccountParam) (*veritrans.Account, error)
+	// GetAccount function gets the veritrans account
+	GetAccount(ctx context.Context, accountParam *veritrans.AccountParam) (*veritrans.Account, error)
+	// DeleteAccount function deletes the veritrans account immediately or on the delete date
+	DeleteAccount(ctx context.Context, accountParam *veritrans.AccountParam) (*veritrans.Account, error)
+	// RestoreAccount function restores the deleted veritrans account
+	RestoreAccount(ctx context.Context, accountParam *veritrans.AccountParam) (*veritrans.Account, error)
 	// CreateCard function adds a card into the account
-	CreateCard(accountParam *veritrans.AccountParam) (*veritrans.Account, error)
+	CreateCard(ctx context.Context, accountParam *veritrans.AccountParam) (*veritrans.Account, error)
 	// UpdateCard function adds a card into the account
-	UpdateCard(accountParam *veritrans.AccountParam) (*veritrans.Account, error)
+	UpdateCard(ctx context.Context, accountParam *veritrans.AccountParam) (*veritrans.Account, error)
 	// DeleteCard function adds a card into the account
-	DeleteCard(accountParam *veritrans.AccountParam) (*veritrans.Account, error)
+	DeleteCard(ctx context.Context, accountParam *veritrans.AccountParam) (*veritrans.Account, error)
 	// GetCard function adds a card into the account
-	GetCard(accountParam *veritrans.AccountParam) (*veritrans.Account, error)
-	// Authorize function executes the veritrans payment
-	Authorize(param *veritrans.Params) error
-	// Authorize function executes the veritrans payment
-	Capture(param *veritrans.Params) error
-	// Cancel function cancels the veritrans payment
-	Cancel(param *veritrans.Params) error
+	GetCard(ctx context.Context, accountParam *veritrans.AccountParam) (*veritrans.Account, error)
+	// CreateRecurringCharge function registers a recurring charge on the account
+	CreateRecurringCharge(ctx context.Context, accountParam *veritrans.AccountParam) (*veritrans.Account, error)
+	// UpdateRecurringCharge function updates the recurring charge of the account
+	UpdateRecurringCharge(ctx context.Context, accountParam *veritrans.AccountParam) (*veritrans.Account, error)
+	// DeleteRecurringCharge function stops the recurring charge of the account
+	DeleteRecurringCharge(ctx context.Context, accountParam *veritrans.AccountParam) (*veritrans.Account, error)
+	// GetRecurringCharge function gets the recurring charge of the account
+	GetRecurringCharge(ctx context.Context, accountParam *veritrans.AccountParam) (*veritrans.Account, error)
+	// Authorize function executes the veritrans payment of the service type
+	Authorize(ctx context.Context, param *veritrans.Params, serviceType veritrans.PaymentServiceType) (*veritrans.Result, error)
+	// Capture function captures the authorized veritrans payment of the service type
+	Capture(ctx context.Context, param *veritrans.Params, serviceType veritrans.PaymentServiceType) (*veritrans.Result, error)
+	// Cancel function cancels the veritrans payment of the service type
+	Cancel(ctx context.Context, param *veritrans.Params, serviceType veritrans.PaymentServiceType) (*veritrans.Result, error)
+	// Refund function refunds the paid veritrans payment of the service type
+	Refund(ctx context.Context, param *veritrans.Params, serviceType veritrans.PaymentServiceType) (*veritrans.Result, error)
+	// Complete function verifies the callback of the redirect payment of the service type and completes it
+	Complete(ctx context.Context, values url.Values, serviceType veritrans.PaymentServiceType) (*veritrans.Result, error)
+	// PartialCapture function captures a part of the authorized amount of the service type
+	PartialCapture(ctx context.Context, param *veritrans.Params, serviceType veritrans.PaymentServiceType) (*veritrans.Result, error)
+	// PartialCancel function refunds a part of the captured amount of the service type
+	PartialCancel(ctx context.Context, param *veritrans.Params, serviceType veritrans.PaymentServiceType) (*veritrans.Result, error)
+	// ReAuthorize function authorizes the card of the original order again with the new amount
+	ReAuthorize(ctx context.Context, param *veritrans.Params, cancelOriginal bool) (*veritrans.Result, error)
+	// PayWithToken function authorizes the card payment with the MDK token, and saves the card to the account if given
+	PayWithToken(ctx context.Context, param *veritrans.Params) (*veritrans.Result, error)
+	// SearchOrders function searches the orders with their transaction history
+	SearchOrders(ctx context.Context, param *veritrans.Params) (*veritrans.OrderInfos, error)
 }
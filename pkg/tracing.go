@@ -0,0 +1,362 @@
+package pkg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+
+	"github.com/david1992121/veritrans-microservice/internal/veritrans"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.7.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/david1992121/veritrans-microservice/pkg"
+
+// AttributeErrorCategory is the span attribute of the veritrans error category
+const AttributeErrorCategory = attribute.Key("veritrans.error_category")
+
+// TracingConfig is a configuration of the tracing
+// Exporter is the span exporter ("otlp" or "none")
+// The otlp exporter is configured by the OTEL_EXPORTER_OTLP_* environment variables
+// SampleRatio is the ratio of the traces sampled when the parent span is not sampled
+type TracingConfig struct {
+	Exporter    string
+	ServiceName string
+	SampleRatio float64
+}
+
+// DefaultTracingConfig returns the default tracing configuration
+func DefaultTracingConfig() TracingConfig {
+	return TracingConfig{
+		Exporter:    "none",
+		ServiceName: "veritrans-microservice",
+		SampleRatio: 1,
+	}
+}
+
+// GetTracingConfig initializes the tracing configuration from the environment
+func GetTracingConfig() TracingConfig {
+	config := DefaultTracingConfig()
+	if exporter := os.Getenv("TRACING_EXPORTER"); exporter != "" {
+		config.Exporter = exporter
+	}
+	if serviceName := os.Getenv("TRACING_SERVICE_NAME"); serviceName != "" {
+		config.ServiceName = serviceName
+	}
+	config.SampleRatio = envFloat("TRACING_SAMPLE_RATIO", config.SampleRatio)
+	return config
+}
+
+// NewTracerProvider initializes the tracer provider and sets it as the global provider
+// with the W3C trace context propagator
+func NewTracerProvider(ctx context.Context, config TracingConfig) (*sdktrace.TracerProvider, error) {
+	options := []sdktrace.TracerProviderOption{
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(config.SampleRatio))),
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceNameKey.String(config.ServiceName),
+		)),
+	}
+
+	switch config.Exporter {
+	case "otlp":
+		exporter, err := otlptracehttp.New(ctx)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, sdktrace.WithBatcher(exporter))
+	case "none", "":
+	default:
+		return nil, fmt.Errorf("unknown tracing exporter %s", config.Exporter)
+	}
+
+	provider := sdktrace.NewTracerProvider(options...)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+	return provider, nil
+}
+
+type tracingMiddleware struct {
+	next Service
+}
+
+// NewTracingMiddleware function
+func NewTracingMiddleware(service Service) Service {
+	return tracingMiddleware{
+		next: service,
+	}
+}
+
+// Call the next service in the span of the method
+// The closure returns the output of the service, and the result code is recorded for the payments
+func (mw tracingMiddleware) trace(ctx context.Context, method string, call func(ctx context.Context) (interface{}, error), attributes ...attribute.KeyValue) error {
+	ctx, span := startSpan(ctx, method, attributes...)
+	output, err := call(ctx)
+	result, _ := output.(*veritrans.Result)
+	endSpan(span, result, err)
+	return err
+}
+
+// Start the span of the service method
+func startSpan(ctx context.Context, method string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, "veritrans."+method, trace.WithAttributes(attributes...))
+}
+
+// End the span with the result of the service method
+func endSpan(span trace.Span, result *veritrans.Result, err error) {
+	if result != nil {
+		span.SetAttributes(
+			veritrans.AttributeResultCode.String(result.VResultCode),
+			veritrans.AttributeMStatus.String(result.MStatus),
+		)
+	}
+	if err != nil {
+		var apiErr *veritrans.APIError
+		if errors.As(err, &apiErr) {
+			span.SetAttributes(veritrans.AttributeResultCode.String(apiErr.VResultCode))
+		}
+		span.SetAttributes(AttributeErrorCategory.String(getCategory(err)))
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Get the span attributes of the payment parameters
+func getPaymentAttributes(param *veritrans.Params, serviceType veritrans.PaymentServiceType) []attribute.KeyValue {
+	attributes := []attribute.KeyValue{
+		veritrans.AttributeServiceType.String(serviceType.String()),
+	}
+	if param != nil && param.OrderID != "" {
+		attributes = append(attributes, veritrans.AttributeOrderID.String(param.OrderID))
+	}
+	return attributes
+}
+
+// GetMDKToken function
+func (mw tracingMiddleware) GetMDKToken(ctx context.Context, cardInfo *veritrans.ClientCardInfo) (output string, err error) {
+	err = mw.trace(ctx, "GetMDKToken", func(ctx context.Context) (interface{}, error) {
+		output, err = mw.next.GetMDKToken(ctx, cardInfo)
+		return output, err
+	})
+	return
+}
+
+// CreateAccount function
+func (mw tracingMiddleware) CreateAccount(ctx context.Context, accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
+	err = mw.trace(ctx, "CreateAccount", func(ctx context.Context) (interface{}, error) {
+		account, err = mw.next.CreateAccount(ctx, accountParam)
+		return account, err
+	})
+	return
+}
+
+// UpdateAccount function
+func (mw tracingMiddleware) UpdateAccount(ctx context.Context, accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
+	err = mw.trace(ctx, "UpdateAccount", func(ctx context.Context) (interface{}, error) {
+		account, err = mw.next.UpdateAccount(ctx, accountParam)
+		return account, err
+	})
+	return
+}
+
+// GetAccount function
+func (mw tracingMiddleware) GetAccount(ctx context.Context, accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
+	err = mw.trace(ctx, "GetAccount", func(ctx context.Context) (interface{}, error) {
+		account, err = mw.next.GetAccount(ctx, accountParam)
+		return account, err
+	})
+	return
+}
+
+// DeleteAccount function
+func (mw tracingMiddleware) DeleteAccount(ctx context.Context, accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
+	err = mw.trace(ctx, "DeleteAccount", func(ctx context.Context) (interface{}, error) {
+		account, err = mw.next.DeleteAccount(ctx, accountParam)
+		return account, err
+	})
+	return
+}
+
+// RestoreAccount function
+func (mw tracingMiddleware) RestoreAccount(ctx context.Context, accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
+	err = mw.trace(ctx, "RestoreAccount", func(ctx context.Context) (interface{}, error) {
+		account, err = mw.next.RestoreAccount(ctx, accountParam)
+		return account, err
+	})
+	return
+}
+
+// CreateCard function
+func (mw tracingMiddleware) CreateCard(ctx context.Context, accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
+	err = mw.trace(ctx, "CreateCard", func(ctx context.Context) (interface{}, error) {
+		account, err = mw.next.CreateCard(ctx, accountParam)
+		return account, err
+	})
+	return
+}
+
+// UpdateCard function
+func (mw tracingMiddleware) UpdateCard(ctx context.Context, accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
+	err = mw.trace(ctx, "UpdateCard", func(ctx context.Context) (interface{}, error) {
+		account, err = mw.next.UpdateCard(ctx, accountParam)
+		return account, err
+	})
+	return
+}
+
+// DeleteCard function
+func (mw tracingMiddleware) DeleteCard(ctx context.Context, accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
+	err = mw.trace(ctx, "DeleteCard", func(ctx context.Context) (interface{}, error) {
+		account, err = mw.next.DeleteCard(ctx, accountParam)
+		return account, err
+	})
+	return
+}
+
+// GetCard function
+func (mw tracingMiddleware) GetCard(ctx context.Context, accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
+	err = mw.trace(ctx, "GetCard", func(ctx context.Context) (interface{}, error) {
+		account, err = mw.next.GetCard(ctx, accountParam)
+		return account, err
+	})
+	return
+}
+
+// CreateRecurringCharge function
+func (mw tracingMiddleware) CreateRecurringCharge(ctx context.Context, accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
+	err = mw.trace(ctx, "CreateRecurringCharge", func(ctx context.Context) (interface{}, error) {
+		account, err = mw.next.CreateRecurringCharge(ctx, accountParam)
+		return account, err
+	})
+	return
+}
+
+// UpdateRecurringCharge function
+func (mw tracingMiddleware) UpdateRecurringCharge(ctx context.Context, accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
+	err = mw.trace(ctx, "UpdateRecurringCharge", func(ctx context.Context) (interface{}, error) {
+		account, err = mw.next.UpdateRecurringCharge(ctx, accountParam)
+		return account, err
+	})
+	return
+}
+
+// DeleteRecurringCharge function
+func (mw tracingMiddleware) DeleteRecurringCharge(ctx context.Context, accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
+	err = mw.trace(ctx, "DeleteRecurringCharge", func(ctx context.Context) (interface{}, error) {
+		account, err = mw.next.DeleteRecurringCharge(ctx, accountParam)
+		return account, err
+	})
+	return
+}
+
+// GetRecurringCharge function
+func (mw tracingMiddleware) GetRecurringCharge(ctx context.Context, accountParam *veritrans.AccountParam) (account *veritrans.Account, err error) {
+	err = mw.trace(ctx, "GetRecurringCharge", func(ctx context.Context) (interface{}, error) {
+		account, err = mw.next.GetRecurringCharge(ctx, accountParam)
+		return account, err
+	})
+	return
+}
+
+// Authorize function
+func (mw tracingMiddleware) Authorize(ctx context.Context, param *veritrans.Params, serviceType veritrans.PaymentServiceType) (result *veritrans.Result, err error) {
+	err = mw.trace(ctx, "Authorize", func(ctx context.Context) (interface{}, error) {
+		result, err = mw.next.Authorize(ctx, param, serviceType)
+		return result, err
+	}, getPaymentAttributes(param, serviceType)...)
+	return
+}
+
+// Cancel function
+func (mw tracingMiddleware) Cancel(ctx context.Context, param *veritrans.Params, serviceType veritrans.PaymentServiceType) (result *veritrans.Result, err error) {
+	err = mw.trace(ctx, "Cancel", func(ctx context.Context) (interface{}, error) {
+		result, err = mw.next.Cancel(ctx, param, serviceType)
+		return result, err
+	}, getPaymentAttributes(param, serviceType)...)
+	return
+}
+
+// Capture function
+func (mw tracingMiddleware) Capture(ctx context.Context, param *veritrans.Params, serviceType veritrans.PaymentServiceType) (result *veritrans.Result, err error) {
+	err = mw.trace(ctx, "Capture", func(ctx context.Context) (interface{}, error) {
+		result, err = mw.next.Capture(ctx, param, serviceType)
+		return result, err
+	}, getPaymentAttributes(param, serviceType)...)
+	return
+}
+
+// Refund function
+func (mw tracingMiddleware) Refund(ctx context.Context, param *veritrans.Params, serviceType veritrans.PaymentServiceType) (result *veritrans.Result, err error) {
+	err = mw.trace(ctx, "Refund", func(ctx context.Context) (interface{}, error) {
+		result, err = mw.next.Refund(ctx, param, serviceType)
+		return result, err
+	}, getPaymentAttributes(param, serviceType)...)
+	return
+}
+
+// Complete function
+func (mw tracingMiddleware) Complete(ctx context.Context, values url.Values, serviceType veritrans.PaymentServiceType) (result *veritrans.Result, err error) {
+	err = mw.trace(ctx, "Complete", func(ctx context.Context) (interface{}, error) {
+		result, err = mw.next.Complete(ctx, values, serviceType)
+		return result, err
+	}, getPaymentAttributes(&veritrans.Params{OrderID: values.Get("orderId")}, serviceType)...)
+	return
+}
+
+// PartialCapture function
+func (mw tracingMiddleware) PartialCapture(ctx context.Context, param *veritrans.Params, serviceType veritrans.PaymentServiceType) (result *veritrans.Result, err error) {
+	err = mw.trace(ctx, "PartialCapture", func(ctx context.Context) (interface{}, error) {
+		result, err = mw.next.PartialCapture(ctx, param, serviceType)
+		return result, err
+	}, getPaymentAttributes(param, serviceType)...)
+	return
+}
+
+// PartialCancel function
+func (mw tracingMiddleware) PartialCancel(ctx context.Context, param *veritrans.Params, serviceType veritrans.PaymentServiceType) (result *veritrans.Result, err error) {
+	err = mw.trace(ctx, "PartialCancel", func(ctx context.Context) (interface{}, error) {
+		result, err = mw.next.PartialCancel(ctx, param, serviceType)
+		return result, err
+	}, getPaymentAttributes(param, serviceType)...)
+	return
+}
+
+// ReAuthorize function
+func (mw tracingMiddleware) ReAuthorize(ctx context.Context, param *veritrans.Params, cancelOriginal bool) (result *veritrans.Result, err error) {
+	err = mw.trace(ctx, "ReAuthorize", func(ctx context.Context) (interface{}, error) {
+		result, err = mw.next.ReAuthorize(ctx, param, cancelOriginal)
+		return result, err
+	}, getPaymentAttributes(param, veritrans.PayCard)...)
+	return
+}
+
+// PayWithToken function
+func (mw tracingMiddleware) PayWithToken(ctx context.Context, param *veritrans.Params) (result *veritrans.Result, err error) {
+	err = mw.trace(ctx, "PayWithToken", func(ctx context.Context) (interface{}, error) {
+		result, err = mw.next.PayWithToken(ctx, param)
+		return result, err
+	}, getPaymentAttributes(param, veritrans.PayCard)...)
+	return
+}
+
+// SearchOrders function
+func (mw tracingMiddleware) SearchOrders(ctx context.Context, param *veritrans.Params) (orderInfos *veritrans.OrderInfos, err error) {
+	err = mw.trace(ctx, "SearchOrders", func(ctx context.Context) (interface{}, error) {
+		orderInfos, err = mw.next.SearchOrders(ctx, param)
+		return orderInfos, err
+	}, getPaymentAttributes(param, veritrans.Search)...)
+	return
+}
@@ -0,0 +1,71 @@
+package pkg
+
+import (
+	"context"
+	"testing"
+
+	"github.com/david1992121/veritrans-microservice/internal/veritrans"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// Record the spans to the in-memory exporter
+func getTestExporter() *tracetest.InMemoryExporter {
+	exporter := tracetest.NewInMemoryExporter()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	return exporter
+}
+
+// Get the attributes of the span as a map
+func getAttributes(span tracetest.SpanStub) map[attribute.Key]string {
+	attributes := map[attribute.Key]string{}
+	for _, item := range span.Attributes {
+		attributes[item.Key] = item.Value.Emit()
+	}
+	return attributes
+}
+
+func TestTracingMiddleware(t *testing.T) {
+	ctx := context.Background()
+	exporter := getTestExporter()
+
+	_, err := NewTracingMiddleware(stubService{}).Authorize(ctx, &veritrans.Params{
+		OrderID:       "order-1",
+		PayNowIDParam: &veritrans.PayNowIDParam{Token: testToken},
+	}, veritrans.PayCard)
+	assert.NoError(t, err)
+	_, err = NewTracingMiddleware(declinedService{}).Authorize(ctx, &veritrans.Params{OrderID: "order-2"}, veritrans.PayCard)
+	assert.Error(t, err)
+	_, err = NewTracingMiddleware(stubService{}).GetMDKToken(ctx, &veritrans.ClientCardInfo{
+		CardNumber:   testCardNumber,
+		SecurityCode: testSecurityCode,
+	})
+	assert.NoError(t, err)
+
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 3)
+
+	assert.Equal(t, "veritrans.Authorize", spans[0].Name)
+	attributes := getAttributes(spans[0])
+	assert.Equal(t, "order-1", attributes[veritrans.AttributeOrderID])
+	assert.Equal(t, "card", attributes[veritrans.AttributeServiceType])
+	assert.Equal(t, "A001000000000000", attributes[veritrans.AttributeResultCode])
+
+	attributes = getAttributes(spans[1])
+	assert.Equal(t, "order-2", attributes[veritrans.AttributeOrderID])
+	assert.Equal(t, "AG33000000000000", attributes[veritrans.AttributeResultCode])
+	assert.Equal(t, "card_declined", attributes[AttributeErrorCategory])
+	assert.Equal(t, codes.Error, spans[1].Status.Code)
+
+	for _, span := range spans {
+		for _, value := range getAttributes(span) {
+			assert.NotContains(t, value, testCardNumber)
+			assert.NotContains(t, value, testSecurityCode)
+			assert.NotContains(t, value, testToken)
+		}
+	}
+}
@@ -2,88 +2,195 @@ package transport
 
 import (
 	"context"
+	"errors"
 
 	"github.com/david1992121/veritrans-microservice/api/pb"
 	"github.com/david1992121/veritrans-microservice/internal/veritrans"
-	"github.com/david1992121/veritrans-microservice/pkg"
 	"github.com/david1992121/veritrans-microservice/pkg/endpoint"
 	grpctransport "github.com/go-kit/kit/transport/grpc"
 	"github.com/go-kit/log"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type grpcServer struct {
-	getMDKToken   grpctransport.Handler
-	createAccount grpctransport.Handler
-	updateAccount grpctransport.Handler
-	createCard    grpctransport.Handler
-	updateCard    grpctransport.Handler
-	deleteCard    grpctransport.Handler
-	getCard       grpctransport.Handler
-	authorize     grpctransport.Handler
-	capture       grpctransport.Handler
-	cancel        grpctransport.Handler
+	getMDKToken           grpctransport.Handler
+	createAccount         grpctransport.Handler
+	updateAccount         grpctransport.Handler
+	getAccount            grpctransport.Handler
+	deleteAccount         grpctransport.Handler
+	restoreAccount        grpctransport.Handler
+	createCard            grpctransport.Handler
+	updateCard            grpctransport.Handler
+	deleteCard            grpctransport.Handler
+	getCard               grpctransport.Handler
+	createRecurringCharge grpctransport.Handler
+	updateRecurringCharge grpctransport.Handler
+	deleteRecurringCharge grpctransport.Handler
+	getRecurringCharge    grpctransport.Handler
+	authorize             grpctransport.Handler
+	capture               grpctransport.Handler
+	cancel                grpctransport.Handler
+	refund                grpctransport.Handler
+	partialCapture        grpctransport.Handler
+	partialCancel         grpctransport.Handler
+	reAuthorize           grpctransport.Handler
+	payWithToken          grpctransport.Handler
+	searchOrders          grpctransport.Handler
 	pb.UnimplementedVeritransServer
 }
 
 // GetGRPCServer returns the handler
 func GetGRPCServer(logger log.Logger) pb.VeritransServer {
-	service := pkg.NewLoggingMiddleware(logger, pkg.NewService(pkg.GetServiceConfig()))
-	eps := endpoint.NewEndpointSet(service)
-	return NewGRPCServer(eps)
+	return NewGRPCServer(getEndpointSet(logger))
 }
 
 // NewGRPCServer function intializes a new gRPC server
 func NewGRPCServer(ep endpoint.Set) pb.VeritransServer {
+	options := []grpctransport.ServerOption{
+		grpctransport.ServerBefore(startGRPCSpan),
+		grpctransport.ServerFinalizer(endGRPCSpan),
+	}
+
 	return &grpcServer{
 		getMDKToken: grpctransport.NewServer(
 			ep.GetMDKTokenEndpoint,
 			decodeGRPCMDKRequest,
 			encodeMDKResponse,
+			options...,
 		),
 		createAccount: grpctransport.NewServer(
 			ep.CreateAccountEndpoint,
 			decodeGRPCAccountRequest,
 			encodeAccountResponse,
+			options...,
 		),
 		updateAccount: grpctransport.NewServer(
 			ep.UpdateAccountEndpoint,
 			decodeGRPCAccountRequest,
 			encodeAccountResponse,
+			options...,
+		),
+		getAccount: grpctransport.NewServer(
+			ep.GetAccountEndpoint,
+			decodeGRPCAccountRequest,
+			encodeAccountResponse,
+			options...,
+		),
+		deleteAccount: grpctransport.NewServer(
+			ep.DeleteAccountEndpoint,
+			decodeGRPCAccountRequest,
+			encodeAccountResponse,
+			options...,
+		),
+		restoreAccount: grpctransport.NewServer(
+			ep.RestoreAccountEndpoint,
+			decodeGRPCAccountRequest,
+			encodeAccountResponse,
+			options...,
 		),
 		createCard: grpctransport.NewServer(
 			ep.CreateCardEndpoint,
 			decodeGRPCAccountRequest,
 			encodeAccountResponse,
+			options...,
 		),
 		updateCard: grpctransport.NewServer(
 			ep.UpdateCardEndpoint,
 			decodeGRPCAccountRequest,
 			encodeAccountResponse,
+			options...,
 		),
 		deleteCard: grpctransport.NewServer(
 			ep.DeleteCardEndpoint,
 			decodeGRPCAccountRequest,
 			encodeAccountResponse,
+			options...,
 		),
 		getCard: grpctransport.NewServer(
 			ep.GetCardEndpoint,
 			decodeGRPCAccountRequest,
 			encodeAccountResponse,
+			options...,
+		),
+		createRecurringCharge: grpctransport.NewServer(
+			ep.CreateRecurringChargeEndpoint,
+			decodeGRPCRecurringChargeRequest,
+			encodeRecurringChargeResponse,
+			options...,
+		),
+		updateRecurringCharge: grpctransport.NewServer(
+			ep.UpdateRecurringChargeEndpoint,
+			decodeGRPCRecurringChargeRequest,
+			encodeRecurringChargeResponse,
+			options...,
+		),
+		deleteRecurringCharge: grpctransport.NewServer(
+			ep.DeleteRecurringChargeEndpoint,
+			decodeGRPCRecurringChargeRequest,
+			encodeRecurringChargeResponse,
+			options...,
+		),
+		getRecurringCharge: grpctransport.NewServer(
+			ep.GetRecurringChargeEndpoint,
+			decodeGRPCRecurringChargeRequest,
+			encodeRecurringChargeResponse,
+			options...,
 		),
 		authorize: grpctransport.NewServer(
 			ep.AuthorizeEndpoint,
-			decodeGRPCPaymentRequest,
+			decodeGRPCServicePaymentRequest,
 			encodePaymentResponse,
+			options...,
 		),
 		capture: grpctransport.NewServer(
 			ep.CaptureEndpoint,
-			decodeGRPCPaymentRequest,
+			decodeGRPCServicePaymentRequest,
 			encodePaymentResponse,
+			options...,
 		),
 		cancel: grpctransport.NewServer(
 			ep.CancelEndpoint,
-			decodeGRPCPaymentRequest,
+			decodeGRPCServicePaymentRequest,
+			encodePaymentResponse,
+			options...,
+		),
+		refund: grpctransport.NewServer(
+			ep.RefundEndpoint,
+			decodeGRPCServicePaymentRequest,
+			encodePaymentResponse,
+			options...,
+		),
+		partialCapture: grpctransport.NewServer(
+			ep.PartialCaptureEndpoint,
+			decodeGRPCServicePaymentRequest,
+			encodePaymentResponse,
+			options...,
+		),
+		partialCancel: grpctransport.NewServer(
+			ep.PartialCancelEndpoint,
+			decodeGRPCServicePaymentRequest,
 			encodePaymentResponse,
+			options...,
+		),
+		reAuthorize: grpctransport.NewServer(
+			ep.ReAuthorizeEndpoint,
+			decodeGRPCReAuthorizeRequest,
+			encodePaymentResponse,
+			options...,
+		),
+		payWithToken: grpctransport.NewServer(
+			ep.PayWithTokenEndpoint,
+			decodeGRPCTokenPaymentRequest,
+			encodePaymentResponse,
+			options...,
+		),
+		searchOrders: grpctransport.NewServer(
+			ep.SearchOrdersEndpoint,
+			decodeGRPCSearchRequest,
+			encodeSearchResponse,
+			options...,
 		),
 	}
 }
@@ -91,7 +198,7 @@ func NewGRPCServer(ep endpoint.Set) pb.VeritransServer {
 func (g *grpcServer) GetMDKToken(ctx context.Context, r *pb.GetMDKTokenRequest) (*pb.TokenReply, error) {
 	_, rep, err := g.getMDKToken.ServeGRPC(ctx, r)
 	if err != nil {
-		return nil, err
+		return nil, getGRPCError(err)
 	}
 	return rep.(*pb.TokenReply), nil
 }
@@ -99,7 +206,7 @@ func (g *grpcServer) GetMDKToken(ctx context.Context, r *pb.GetMDKTokenRequest)
 func (g *grpcServer) CreateAccount(ctx context.Context, r *pb.AccountRequest) (*pb.AccountReply, error) {
 	_, rep, err := g.createAccount.ServeGRPC(ctx, r)
 	if err != nil {
-		return nil, err
+		return nil, getGRPCError(err)
 	}
 	return rep.(*pb.AccountReply), nil
 }
@@ -107,7 +214,31 @@ func (g *grpcServer) CreateAccount(ctx context.Context, r *pb.AccountRequest) (*
 func (g *grpcServer) UpdateAccount(ctx context.Context, r *pb.AccountRequest) (*pb.AccountReply, error) {
 	_, rep, err := g.updateAccount.ServeGRPC(ctx, r)
 	if err != nil {
-		return nil, err
+		return nil, getGRPCError(err)
+	}
+	return rep.(*pb.AccountReply), nil
+}
+
+func (g *grpcServer) GetAccount(ctx context.Context, r *pb.AccountRequest) (*pb.AccountReply, error) {
+	_, rep, err := g.getAccount.ServeGRPC(ctx, r)
+	if err != nil {
+		return nil, getGRPCError(err)
+	}
+	return rep.(*pb.AccountReply), nil
+}
+
+func (g *grpcServer) DeleteAccount(ctx context.Context, r *pb.AccountRequest) (*pb.AccountReply, error) {
+	_, rep, err := g.deleteAccount.ServeGRPC(ctx, r)
+	if err != nil {
+		return nil, getGRPCError(err)
+	}
+	return rep.(*pb.AccountReply), nil
+}
+
+func (g *grpcServer) RestoreAccount(ctx context.Context, r *pb.AccountRequest) (*pb.AccountReply, error) {
+	_, rep, err := g.restoreAccount.ServeGRPC(ctx, r)
+	if err != nil {
+		return nil, getGRPCError(err)
 	}
 	return rep.(*pb.AccountReply), nil
 }
@@ -115,7 +246,7 @@ func (g *grpcServer) UpdateAccount(ctx context.Context, r *pb.AccountRequest) (*
 func (g *grpcServer) CreateCard(ctx context.Context, r *pb.AccountRequest) (*pb.AccountReply, error) {
 	_, rep, err := g.createCard.ServeGRPC(ctx, r)
 	if err != nil {
-		return nil, err
+		return nil, getGRPCError(err)
 	}
 	return rep.(*pb.AccountReply), nil
 }
@@ -123,7 +254,7 @@ func (g *grpcServer) CreateCard(ctx context.Context, r *pb.AccountRequest) (*pb.
 func (g *grpcServer) UpdateCard(ctx context.Context, r *pb.AccountRequest) (*pb.AccountReply, error) {
 	_, rep, err := g.updateCard.ServeGRPC(ctx, r)
 	if err != nil {
-		return nil, err
+		return nil, getGRPCError(err)
 	}
 	return rep.(*pb.AccountReply), nil
 }
@@ -131,7 +262,7 @@ func (g *grpcServer) UpdateCard(ctx context.Context, r *pb.AccountRequest) (*pb.
 func (g *grpcServer) DeleteCard(ctx context.Context, r *pb.AccountRequest) (*pb.AccountReply, error) {
 	_, rep, err := g.deleteCard.ServeGRPC(ctx, r)
 	if err != nil {
-		return nil, err
+		return nil, getGRPCError(err)
 	}
 	return rep.(*pb.AccountReply), nil
 }
@@ -139,15 +270,47 @@ func (g *grpcServer) DeleteCard(ctx context.Context, r *pb.AccountRequest) (*pb.
 func (g *grpcServer) GetCard(ctx context.Context, r *pb.AccountRequest) (*pb.AccountReply, error) {
 	_, rep, err := g.getCard.ServeGRPC(ctx, r)
 	if err != nil {
-		return nil, err
+		return nil, getGRPCError(err)
 	}
 	return rep.(*pb.AccountReply), nil
 }
 
+func (g *grpcServer) CreateRecurringCharge(ctx context.Context, r *pb.RecurringChargeRequest) (*pb.RecurringChargeReply, error) {
+	_, rep, err := g.createRecurringCharge.ServeGRPC(ctx, r)
+	if err != nil {
+		return nil, getGRPCError(err)
+	}
+	return rep.(*pb.RecurringChargeReply), nil
+}
+
+func (g *grpcServer) UpdateRecurringCharge(ctx context.Context, r *pb.RecurringChargeRequest) (*pb.RecurringChargeReply, error) {
+	_, rep, err := g.updateRecurringCharge.ServeGRPC(ctx, r)
+	if err != nil {
+		return nil, getGRPCError(err)
+	}
+	return rep.(*pb.RecurringChargeReply), nil
+}
+
+func (g *grpcServer) DeleteRecurringCharge(ctx context.Context, r *pb.RecurringChargeRequest) (*pb.RecurringChargeReply, error) {
+	_, rep, err := g.deleteRecurringCharge.ServeGRPC(ctx, r)
+	if err != nil {
+		return nil, getGRPCError(err)
+	}
+	return rep.(*pb.RecurringChargeReply), nil
+}
+
+func (g *grpcServer) GetRecurringCharge(ctx context.Context, r *pb.RecurringChargeRequest) (*pb.RecurringChargeReply, error) {
+	_, rep, err := g.getRecurringCharge.ServeGRPC(ctx, r)
+	if err != nil {
+		return nil, getGRPCError(err)
+	}
+	return rep.(*pb.RecurringChargeReply), nil
+}
+
 func (g *grpcServer) Authorize(ctx context.Context, r *pb.PaymentRequest) (*pb.PaymentReply, error) {
 	_, rep, err := g.authorize.ServeGRPC(ctx, r)
 	if err != nil {
-		return nil, err
+		return nil, getGRPCError(err)
 	}
 	return rep.(*pb.PaymentReply), nil
 }
@@ -155,7 +318,7 @@ func (g *grpcServer) Authorize(ctx context.Context, r *pb.PaymentRequest) (*pb.P
 func (g *grpcServer) Capture(ctx context.Context, r *pb.PaymentRequest) (*pb.PaymentReply, error) {
 	_, rep, err := g.capture.ServeGRPC(ctx, r)
 	if err != nil {
-		return nil, err
+		return nil, getGRPCError(err)
 	}
 	return rep.(*pb.PaymentReply), nil
 }
@@ -163,11 +326,59 @@ func (g *grpcServer) Capture(ctx context.Context, r *pb.PaymentRequest) (*pb.Pay
 func (g *grpcServer) Cancel(ctx context.Context, r *pb.PaymentRequest) (*pb.PaymentReply, error) {
 	_, rep, err := g.cancel.ServeGRPC(ctx, r)
 	if err != nil {
-		return nil, err
+		return nil, getGRPCError(err)
 	}
 	return rep.(*pb.PaymentReply), nil
 }
 
+func (g *grpcServer) Refund(ctx context.Context, r *pb.PaymentRequest) (*pb.PaymentReply, error) {
+	_, rep, err := g.refund.ServeGRPC(ctx, r)
+	if err != nil {
+		return nil, getGRPCError(err)
+	}
+	return rep.(*pb.PaymentReply), nil
+}
+
+func (g *grpcServer) PartialCapture(ctx context.Context, r *pb.PaymentRequest) (*pb.PaymentReply, error) {
+	_, rep, err := g.partialCapture.ServeGRPC(ctx, r)
+	if err != nil {
+		return nil, getGRPCError(err)
+	}
+	return rep.(*pb.PaymentReply), nil
+}
+
+func (g *grpcServer) PartialCancel(ctx context.Context, r *pb.PaymentRequest) (*pb.PaymentReply, error) {
+	_, rep, err := g.partialCancel.ServeGRPC(ctx, r)
+	if err != nil {
+		return nil, getGRPCError(err)
+	}
+	return rep.(*pb.PaymentReply), nil
+}
+
+func (g *grpcServer) ReAuthorize(ctx context.Context, r *pb.ReAuthorizeRequest) (*pb.PaymentReply, error) {
+	_, rep, err := g.reAuthorize.ServeGRPC(ctx, r)
+	if err != nil {
+		return nil, getGRPCError(err)
+	}
+	return rep.(*pb.PaymentReply), nil
+}
+
+func (g *grpcServer) PayWithToken(ctx context.Context, r *pb.TokenPaymentRequest) (*pb.PaymentReply, error) {
+	_, rep, err := g.payWithToken.ServeGRPC(ctx, r)
+	if err != nil {
+		return nil, getGRPCError(err)
+	}
+	return rep.(*pb.PaymentReply), nil
+}
+
+func (g *grpcServer) SearchOrders(ctx context.Context, r *pb.SearchRequest) (*pb.SearchReply, error) {
+	_, rep, err := g.searchOrders.ServeGRPC(ctx, r)
+	if err != nil {
+		return nil, getGRPCError(err)
+	}
+	return rep.(*pb.SearchReply), nil
+}
+
 func decodeGRPCMDKRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
 	req := grpcReq.(*pb.GetMDKTokenRequest)
 	clientCardInfo := veritrans.ClientCardInfo{
@@ -183,6 +394,9 @@ func decodeGRPCMDKRequest(_ context.Context, grpcReq interface{}) (interface{},
 
 func encodeMDKResponse(_ context.Context, endpointRes interface{}) (interface{}, error) {
 	res := endpointRes.(endpoint.GetMDKTokenResponse)
+	if res.Failed() != nil {
+		return nil, getGRPCError(res.Failed())
+	}
 	return &pb.TokenReply{Token: res.Token, Err: res.Err}, nil
 }
 
@@ -205,11 +419,20 @@ func decodeGRPCAccountRequest(_ context.Context, grpcReq interface{}) (interface
 			accountParam.CardParam.CardID = *req.CardParam.CardID
 		}
 	}
+	if req.AccountBasicParam != nil {
+		accountParam.AccountBasicParam = &veritrans.AccountBasicParam{
+			DeleteDate:      req.AccountBasicParam.GetDeleteDate(),
+			ForceDeleteDate: req.AccountBasicParam.GetForceDeleteDate(),
+		}
+	}
 	return accountParam, nil
 }
 
 func encodeAccountResponse(_ context.Context, endpointRes interface{}) (interface{}, error) {
 	res := endpointRes.(endpoint.AccountResponse)
+	if res.Failed() != nil {
+		return nil, getGRPCError(res.Failed())
+	}
 	var accountReply pb.AccountReply
 	if res.Account != nil {
 		accountReply.Account = &pb.AccountReply_AccountInfo{
@@ -223,35 +446,499 @@ func encodeAccountResponse(_ context.Context, endpointRes interface{}) (interfac
 				DefaultCard: cardItem.DefaultCard,
 			})
 		}
+		if basicParam := res.Account.AccountBasicParam; basicParam != nil {
+			accountReply.Account.AccountBasicInfo = &pb.AccountReply_AccountInfo_AccountBasicInfo{
+				CreateDate:      basicParam.CreateDate,
+				DeleteDate:      basicParam.DeleteDate,
+				ForceDeleteDate: basicParam.ForceDeleteDate,
+			}
+		}
 	}
 	accountReply.Err = res.Err
 	return &accountReply, nil
 }
 
+func decodeGRPCRecurringChargeRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.RecurringChargeRequest)
+	return endpoint.RecurringChargeRequest{
+		AccountID: req.AccountID,
+		RecurringChargeParam: veritrans.RecurringChargeParam{
+			GroupID:       req.GroupID,
+			StartDate:     req.GetStartDate(),
+			EndDate:       req.GetEndDate(),
+			FinalCharge:   req.GetFinalCharge(),
+			OneTimeAmount: req.GetOneTimeAmount(),
+			Amount:        req.GetAmount(),
+		},
+	}, nil
+}
+
+func encodeRecurringChargeResponse(_ context.Context, endpointRes interface{}) (interface{}, error) {
+	res := endpointRes.(endpoint.RecurringChargeResponse)
+	if res.Failed() != nil {
+		return nil, getGRPCError(res.Failed())
+	}
+	reply := pb.RecurringChargeReply{
+		AccountID: res.AccountID,
+		Err:       res.Err,
+	}
+	for _, item := range res.RecurringCharges {
+		reply.RecurringCharges = append(reply.RecurringCharges, &pb.RecurringChargeReply_RecurringCharge{
+			GroupID:       item.GroupID,
+			StartDate:     item.StartDate,
+			EndDate:       item.EndDate,
+			FinalCharge:   item.FinalCharge,
+			OneTimeAmount: item.OneTimeAmount,
+			Amount:        item.Amount,
+		})
+	}
+	return &reply, nil
+}
+
 func decodeGRPCPaymentRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
 	req := grpcReq.(*pb.PaymentRequest)
-	var param veritrans.Params
-	param.OrderID = req.OrderID
-	param.Amount = req.Amount
-	if req.Jpo != nil && *req.Jpo != "" {
-		param.JPO = *req.Jpo
-	}
-	if req.PayNowIDParam != nil {
-		if req.PayNowIDParam.Token != "" {
-			param.PayNowIDParam.Token = req.PayNowIDParam.Token
+	param := veritrans.Params{
+		OrderID:       req.OrderID,
+		Amount:        req.Amount,
+		JPO:           req.GetJpo(),
+		PaymentOption: getPaymentOption(req.PaymentOption),
+		WithCapture:   req.GetWithCapture(),
+		Currency:      req.GetCurrency(),
+		PayNowIDParam: getPayNowIDParam(req.PayNowIDParam),
+	}
+	return param, nil
+}
+
+func decodeGRPCTokenPaymentRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.TokenPaymentRequest)
+	return endpoint.TokenPaymentRequest{
+		OrderID:       req.OrderID,
+		Amount:        req.Amount,
+		Token:         req.Token,
+		AccountID:     req.GetAccountID(),
+		WithCapture:   req.GetWithCapture(),
+		PaymentOption: getPaymentOption(req.PaymentOption),
+	}, nil
+}
+
+func decodeGRPCServicePaymentRequest(ctx context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.PaymentRequest)
+	var param interface{}
+	var err error
+	var accepted []veritrans.PaymentServiceType
+	var capturable bool
+	switch serviceParams := req.ServiceParams.(type) {
+	case *pb.PaymentRequest_Cvs:
+		param, err = decodeGRPCCVSPaymentRequest(ctx, serviceParams.Cvs)
+		accepted = []veritrans.PaymentServiceType{veritrans.CVS}
+	case *pb.PaymentRequest_Bank:
+		param, err = decodeGRPCBankPaymentRequest(ctx, serviceParams.Bank)
+		accepted = []veritrans.PaymentServiceType{veritrans.Bank}
+	case *pb.PaymentRequest_Mpi:
+		param, err = decodeGRPCMPIPaymentRequest(ctx, serviceParams.Mpi)
+		accepted = []veritrans.PaymentServiceType{veritrans.MPI}
+		capturable = true
+	case *pb.PaymentRequest_Paypal:
+		param, err = decodeGRPCPaypalPaymentRequest(ctx, serviceParams.Paypal)
+		accepted = []veritrans.PaymentServiceType{veritrans.Paypal}
+		capturable = true
+	case *pb.PaymentRequest_Foreign:
+		param, err = decodeGRPCForeignPaymentRequest(ctx, serviceParams.Foreign)
+		accepted = []veritrans.PaymentServiceType{veritrans.Alipay, veritrans.UPop}
+		capturable = true
+	case *pb.PaymentRequest_Carrier:
+		param, err = decodeGRPCCarrierPaymentRequest(ctx, serviceParams.Carrier)
+		accepted = []veritrans.PaymentServiceType{veritrans.Carrier}
+		capturable = true
+	case *pb.PaymentRequest_Em:
+		param, err = decodeGRPCEMPaymentRequest(ctx, serviceParams.Em)
+		accepted = []veritrans.PaymentServiceType{veritrans.EM}
+	case *pb.PaymentRequest_Saison:
+		param, err = decodeGRPCSaisonPaymentRequest(ctx, serviceParams.Saison)
+		accepted = []veritrans.PaymentServiceType{veritrans.Saison}
+		capturable = true
+	default:
+		param, err = decodeGRPCPaymentRequest(ctx, req)
+	}
+	if err != nil {
+		return nil, err
+	}
+	serviceType, err := getServiceParamsType(req.GetServiceType(), accepted)
+	if err != nil {
+		return nil, err
+	}
+	payment := param.(veritrans.Params)
+	// the withCapture of the request applies to the service params which leave it out
+	if capturable && payment.WithCapture == "" {
+		payment.WithCapture = req.GetWithCapture()
+	}
+	return endpoint.PaymentRequest{ServiceType: serviceType, Params: payment}, nil
+}
+
+// Check the service type against the service params, or take it from the params if not given
+func getServiceParamsType(serviceType string, accepted []veritrans.PaymentServiceType) (string, error) {
+	if len(accepted) == 0 {
+		return serviceType, nil
+	}
+	if serviceType == "" {
+		if len(accepted) > 1 {
+			return "", &veritrans.ValidationError{Field: "serviceType", Message: "required"}
 		}
-		if req.PayNowIDParam.AccountParam != nil && req.PayNowIDParam.AccountParam.AccountID != "" {
-			param.PayNowIDParam.AccountParam = &veritrans.AccountParam{
-				AccountID: req.PayNowIDParam.AccountParam.AccountID,
-			}
+		return accepted[0].String(), nil
+	}
+	for _, acceptedType := range accepted {
+		if serviceType == acceptedType.String() {
+			return serviceType, nil
 		}
 	}
+	return "", &veritrans.ValidationError{Field: "serviceType", Message: serviceType + " does not match the service params"}
+}
+
+func decodeGRPCCVSPaymentRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.CVSPaymentRequest)
+	param := veritrans.Params{
+		OrderID:           req.OrderID,
+		Amount:            req.Amount,
+		ServiceOptionType: req.ServiceOptionType,
+		Name1:             req.Name1,
+		Name2:             req.GetName2(),
+		TelNo:             req.TelNo,
+		PayLimit:          req.PayLimit,
+		PayLimitHhmm:      req.GetPayLimitHhmm(),
+	}
+	return param, nil
+}
+
+func decodeGRPCBankPaymentRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.BankPaymentRequest)
+	param := veritrans.Params{
+		OrderID:           req.OrderID,
+		Amount:            req.Amount,
+		ServiceOptionType: req.ServiceOptionType,
+		Name1:             req.Name1,
+		Name2:             req.Name2,
+		Kana1:             req.Kana1,
+		Kana2:             req.Kana2,
+		TelNo:             req.GetTelNo(),
+		PayLimit:          req.PayLimit,
+		Contents:          req.GetContents(),
+		ContentsKana:      req.GetContentsKana(),
+	}
+	return param, nil
+}
+
+func decodeGRPCMPIPaymentRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.MPIPaymentRequest)
+	param := veritrans.Params{
+		OrderID:           req.OrderID,
+		Amount:            req.Amount,
+		JPO:               req.GetJpo(),
+		PaymentOption:     getPaymentOption(req.PaymentOption),
+		WithCapture:       req.GetWithCapture(),
+		ServiceOptionType: req.ServiceOptionType,
+		RedirectionURI:    req.RedirectionURI,
+		HTTPUserAgent:     req.GetHttpUserAgent(),
+		HTTPAccept:        req.GetHttpAccept(),
+		PayNowIDParam:     getPayNowIDParam(req.PayNowIDParam),
+	}
+	return param, nil
+}
+
+func decodeGRPCReAuthorizeRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.ReAuthorizeRequest)
+	return endpoint.ReAuthorizeRequest{
+		CancelOriginal: req.GetCancelOriginal(),
+		Params: veritrans.Params{
+			OrderID:         req.OrderID,
+			OriginalOrderID: req.OriginalOrderID,
+			Amount:          req.Amount,
+			JPO:             req.GetJpo(),
+			PaymentOption:   getPaymentOption(req.PaymentOption),
+			WithCapture:     req.GetWithCapture(),
+		},
+	}, nil
+}
+
+func decodeGRPCPaypalPaymentRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.PaypalPaymentRequest)
+	param := veritrans.Params{
+		OrderID:     req.OrderID,
+		Amount:      req.Amount,
+		WithCapture: req.GetWithCapture(),
+		ReturnURL:   req.ReturnURL,
+		CancelURL:   req.CancelURL,
+	}
 	return param, nil
 }
 
+func decodeGRPCForeignPaymentRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.ForeignPaymentRequest)
+	param := veritrans.Params{
+		OrderID:       req.OrderID,
+		Amount:        req.Amount,
+		Currency:      req.GetCurrency(),
+		WithCapture:   req.GetWithCapture(),
+		CommodityName: req.CommodityName,
+		ReturnURL:     req.ReturnURL,
+	}
+	return param, nil
+}
+
+func decodeGRPCCarrierPaymentRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.CarrierPaymentRequest)
+	param := veritrans.Params{
+		OrderID:           req.OrderID,
+		Amount:            req.Amount,
+		WithCapture:       req.GetWithCapture(),
+		ServiceOptionType: req.ServiceOptionType,
+		TerminalKind:      req.TerminalKind,
+		ItemType:          req.ItemType,
+		SuccessURL:        req.SuccessURL,
+		CancelURL:         req.CancelURL,
+		ErrorURL:          req.ErrorURL,
+	}
+	return param, nil
+}
+
+func decodeGRPCEMPaymentRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.EMPaymentRequest)
+	param := veritrans.Params{
+		OrderID:           req.OrderID,
+		Amount:            req.Amount,
+		ServiceOptionType: req.ServiceOptionType,
+		MailAddr:          req.GetMailAddr(),
+		SuccessURL:        req.GetSuccessURL(),
+		CancelURL:         req.GetCancelURL(),
+		ErrorURL:          req.GetErrorURL(),
+	}
+	return param, nil
+}
+
+func decodeGRPCSaisonPaymentRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.SaisonPaymentRequest)
+	param := veritrans.Params{
+		OrderID:       req.OrderID,
+		Amount:        req.Amount,
+		CardAmount:    req.CardAmount,
+		PointAmount:   req.PointAmount,
+		JPO:           req.GetJpo(),
+		PaymentOption: getPaymentOption(req.PaymentOption),
+		WithCapture:   req.GetWithCapture(),
+		PayNowIDParam: getPayNowIDParam(req.PayNowIDParam),
+	}
+	return param, nil
+}
+
+// Get the token or the account of the card from the grpc request
+func getPayNowIDParam(req *pb.PaymentRequest_PayNowIDParam) *veritrans.PayNowIDParam {
+	if req == nil {
+		return nil
+	}
+	payNowIDParam := &veritrans.PayNowIDParam{
+		Token: req.Token,
+	}
+	if req.AccountParam != nil && req.AccountParam.AccountID != "" {
+		payNowIDParam.AccountParam = &veritrans.AccountParam{
+			AccountID: req.AccountParam.AccountID,
+		}
+	}
+	return payNowIDParam
+}
+
+func getPaymentOption(req *pb.PaymentOption) *veritrans.PaymentOption {
+	if req == nil {
+		return nil
+	}
+	return &veritrans.PaymentOption{
+		Type:             req.Type,
+		InstallmentCount: int(req.GetInstallmentCount()),
+	}
+}
+
 func encodePaymentResponse(_ context.Context, endpointRes interface{}) (interface{}, error) {
 	res := endpointRes.(endpoint.PaymentResponse)
+	if res.Failed() != nil {
+		return nil, getGRPCError(res.Failed())
+	}
 	var paymentReply pb.PaymentReply
+	if res.Result != nil {
+		paymentReply.Result = &pb.PaymentReply_TransactionResult{
+			VResultCode:         res.Result.VResultCode,
+			Mstatus:             res.Result.MStatus,
+			OrderID:             res.Result.OrderID,
+			TxnID:               res.Result.CustTxn,
+			Amount:              res.Result.ReqAmount,
+			TxnDateTime:         res.Result.CenterResponseDate,
+			ServiceType:         res.Result.ServiceType,
+			CardTransactionType: res.Result.CardTransactionType,
+		}
+		if res.Result.ReceiptNo != "" {
+			paymentReply.Result.ReceiptNo = &res.Result.ReceiptNo
+		}
+		if res.Result.HaraikomiURL != "" {
+			paymentReply.Result.HaraikomiURL = &res.Result.HaraikomiURL
+		}
+		if res.Result.ShunoKikanNo != "" {
+			paymentReply.Result.ShunoKikanNo = &res.Result.ShunoKikanNo
+		}
+		if res.Result.CustomerNo != "" {
+			paymentReply.Result.CustomerNo = &res.Result.CustomerNo
+		}
+		if res.Result.ConfirmNo != "" {
+			paymentReply.Result.ConfirmNo = &res.Result.ConfirmNo
+		}
+		if res.Result.BillPattern != "" {
+			paymentReply.Result.BillPattern = &res.Result.BillPattern
+		}
+		if res.Result.URL != "" {
+			paymentReply.Result.Url = &res.Result.URL
+		}
+		if res.Result.ResResponseContents != "" {
+			paymentReply.Result.ResResponseContents = &res.Result.ResResponseContents
+		}
+		if res.Result.LoginURL != "" {
+			paymentReply.Result.LoginURL = &res.Result.LoginURL
+		}
+		if res.Result.RedirectURL != "" {
+			paymentReply.Result.RedirectURL = &res.Result.RedirectURL
+		}
+		if res.Result.CarrierOrderID != "" {
+			paymentReply.Result.CarrierOrderID = &res.Result.CarrierOrderID
+		}
+		if res.Result.AppURL != "" {
+			paymentReply.Result.AppURL = &res.Result.AppURL
+		}
+		if res.Result.MailURL != "" {
+			paymentReply.Result.MailURL = &res.Result.MailURL
+		}
+		if res.Result.RefundableAmount != "" {
+			paymentReply.Result.RefundableAmount = &res.Result.RefundableAmount
+		}
+	}
 	paymentReply.Err = res.Err
 	return &paymentReply, nil
 }
+
+func decodeGRPCSearchRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.SearchRequest)
+	searchReq := endpoint.SearchRequest{
+		OrderID:      req.GetOrderID(),
+		AccountID:    req.GetAccountID(),
+		From:         req.GetFrom(),
+		To:           req.GetTo(),
+		ServiceTypes: req.ServiceTypes,
+		NewerFlag:    req.GetNewerFlag(),
+	}
+	return searchReq, nil
+}
+
+func encodeSearchResponse(_ context.Context, endpointRes interface{}) (interface{}, error) {
+	res := endpointRes.(endpoint.SearchResponse)
+	if res.Failed() != nil {
+		return nil, getGRPCError(res.Failed())
+	}
+	var searchReply pb.SearchReply
+	for _, orderItem := range res.Orders {
+		orderInfo := &pb.SearchReply_OrderInfo{
+			OrderID:            orderItem.OrderID,
+			AccountID:          orderItem.AccountID,
+			ServiceTypeCd:      orderItem.ServiceTypeCd,
+			LastSuccessTxnType: orderItem.LastSuccessTxnType,
+		}
+		if orderItem.ProperOrderInfo != nil {
+			orderInfo.ProperOrderInfo = &pb.SearchReply_OrderInfo_ProperOrderInfo{
+				ShunoKikanNo:     orderItem.ProperOrderInfo.ShunoKikanNo,
+				CustomerNo:       orderItem.ProperOrderInfo.CustomerNo,
+				ConfirmNo:        orderItem.ProperOrderInfo.ConfirmNo,
+				PayLimit:         orderItem.ProperOrderInfo.PayLimit,
+				ReceivedDatetime: orderItem.ProperOrderInfo.ReceivedDatetime,
+				CardAmount:       orderItem.ProperOrderInfo.CardAmount,
+				PointAmount:      orderItem.ProperOrderInfo.PointAmount,
+			}
+		}
+		if orderItem.TransactionInfos != nil {
+			for _, txnItem := range orderItem.TransactionInfos.TransactionInfo {
+				orderInfo.TransactionInfo = append(orderInfo.TransactionInfo, &pb.SearchReply_OrderInfo_TransactionInfo{
+					TxnID:               txnItem.TxnID,
+					Command:             txnItem.Command,
+					Mstatus:             txnItem.MStatus,
+					VResultCode:         txnItem.VResultCode,
+					Amount:              txnItem.Amount,
+					TxnDateTime:         txnItem.TxnDateTime,
+					CardTransactionType: txnItem.ProperInfo.CardTransactionType,
+					ReqWithCapture:      txnItem.ProperInfo.ReqWithCapture,
+					ReqJpoInformation:   txnItem.ProperInfo.ReqJPOInformation,
+				})
+			}
+		}
+		searchReply.OrderInfo = append(searchReply.OrderInfo, orderInfo)
+	}
+	searchReply.Err = res.Err
+	return &searchReply, nil
+}
+
+// Get the gRPC status error with the veritrans error details
+func getGRPCError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	switch {
+	case errors.Is(err, endpoint.ErrUpstreamUnavailable):
+		return status.Error(codes.Unavailable, err.Error())
+	case errors.Is(err, endpoint.ErrRateLimited):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+
+	var validationErr *veritrans.ValidationError
+	if errors.As(err, &validationErr) {
+		st := status.New(codes.InvalidArgument, validationErr.Error())
+		detailed, detailErr := st.WithDetails(&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: validationErr.Field, Description: validationErr.Message},
+			},
+		})
+		if detailErr != nil {
+			return st.Err()
+		}
+		return detailed.Err()
+	}
+
+	var apiErr *veritrans.APIError
+	if !errors.As(err, &apiErr) {
+		return status.Error(codes.Unknown, err.Error())
+	}
+
+	category := apiErr.Category()
+	var code codes.Code
+	switch category {
+	case veritrans.CategoryCardDeclined:
+		code = codes.FailedPrecondition
+	case veritrans.CategoryInvalidParameter:
+		code = codes.InvalidArgument
+	case veritrans.CategoryDuplicateOrder, veritrans.CategoryAccountExists:
+		code = codes.AlreadyExists
+	case veritrans.CategoryAccountNotFound:
+		code = codes.NotFound
+	case veritrans.CategoryRetryable:
+		code = codes.Unavailable
+	case veritrans.CategorySystemError:
+		code = codes.Internal
+	default:
+		code = codes.Unknown
+	}
+
+	st := status.New(code, apiErr.Error())
+	detailed, detailErr := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: category.String(),
+		Domain: "veritrans",
+		Metadata: map[string]string{
+			"vResultCode": apiErr.VResultCode,
+			"mstatus":     apiErr.MStatus,
+			"kind":        apiErr.Kind,
+		},
+	})
+	if detailErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
@@ -0,0 +1,124 @@
+package transport
+
+import (
+	"context"
+	"testing"
+
+	"github.com/david1992121/veritrans-microservice/api/pb"
+	"github.com/david1992121/veritrans-microservice/internal/veritrans"
+	"github.com/david1992121/veritrans-microservice/pkg/endpoint"
+	assert "github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEncodeSearchResponse(t *testing.T) {
+	res, err := encodeSearchResponse(context.Background(), endpoint.SearchResponse{
+		Orders: []veritrans.OrderInfo{
+			{
+				OrderID:            "bank-order-001",
+				ServiceTypeCd:      veritrans.PaymentServiceTypes[veritrans.Bank],
+				LastSuccessTxnType: "Authorize",
+				ProperOrderInfo: &veritrans.ProperOrderInfo{
+					ShunoKikanNo: "58191",
+					CustomerNo:   "12345678901234567890",
+					ConfirmNo:    "123456",
+					PayLimit:     "20300101",
+				},
+			},
+			{
+				OrderID:       "card-order-001",
+				ServiceTypeCd: veritrans.PaymentServiceTypes[veritrans.PayCard],
+			},
+			{
+				OrderID:       "saison-order-001",
+				ServiceTypeCd: veritrans.PaymentServiceTypes[veritrans.Saison],
+				ProperOrderInfo: &veritrans.ProperOrderInfo{
+					CardAmount:  "700",
+					PointAmount: "300",
+				},
+			},
+		},
+	})
+	assert.Nil(t, err)
+
+	reply := res.(*pb.SearchReply)
+	assert.Equal(t, 3, len(reply.OrderInfo))
+	bankInfo := reply.OrderInfo[0].ProperOrderInfo
+	assert.NotNil(t, bankInfo)
+	assert.Equal(t, "58191", bankInfo.ShunoKikanNo)
+	assert.Equal(t, "12345678901234567890", bankInfo.CustomerNo)
+	assert.Equal(t, "123456", bankInfo.ConfirmNo)
+	assert.Equal(t, "20300101", bankInfo.PayLimit)
+	assert.Empty(t, bankInfo.ReceivedDatetime)
+	assert.Nil(t, reply.OrderInfo[1].ProperOrderInfo)
+	saisonInfo := reply.OrderInfo[2].ProperOrderInfo
+	assert.Equal(t, "700", saisonInfo.CardAmount)
+	assert.Equal(t, "300", saisonInfo.PointAmount)
+}
+
+func TestDecodeGRPCPaymentRequest(t *testing.T) {
+	withCapture := "true"
+	req, err := decodeGRPCPaymentRequest(context.Background(), &pb.PaymentRequest{
+		OrderID:       "order-1",
+		Amount:        "1000",
+		WithCapture:   &withCapture,
+		PayNowIDParam: &pb.PaymentRequest_PayNowIDParam{Token: "test-token"},
+	})
+	assert.Nil(t, err)
+
+	param := req.(veritrans.Params)
+	assert.Equal(t, "true", param.WithCapture)
+	assert.Equal(t, "test-token", param.PayNowIDParam.Token)
+	assert.Nil(t, param.PayNowIDParam.AccountParam)
+
+	req, err = decodeGRPCPaymentRequest(context.Background(), &pb.PaymentRequest{OrderID: "order-2"})
+	assert.Nil(t, err)
+	assert.Nil(t, req.(veritrans.Params).PayNowIDParam)
+}
+
+func TestDecodeGRPCServicePaymentRequest(t *testing.T) {
+	ctx := context.Background()
+	cvs := &pb.PaymentRequest_Cvs{Cvs: &pb.CVSPaymentRequest{OrderID: "cvs-order-1"}}
+
+	// the service type is taken from the service params
+	req, err := decodeGRPCServicePaymentRequest(ctx, &pb.PaymentRequest{ServiceParams: cvs})
+	assert.Nil(t, err)
+	assert.Equal(t, veritrans.PaymentServiceTypes[veritrans.CVS], req.(endpoint.PaymentRequest).ServiceType)
+	assert.Equal(t, "cvs-order-1", req.(endpoint.PaymentRequest).Params.OrderID)
+
+	// reject the service type which does not match the service params
+	serviceType := veritrans.PaymentServiceTypes[veritrans.EM]
+	_, err = decodeGRPCServicePaymentRequest(ctx, &pb.PaymentRequest{ServiceType: &serviceType, ServiceParams: cvs})
+	assert.True(t, veritrans.IsInvalidParameter(err))
+	assert.Equal(t, codes.InvalidArgument, status.Code(getGRPCError(err)))
+
+	// the foreign params are shared by alipay and upop
+	foreign := &pb.PaymentRequest_Foreign{Foreign: &pb.ForeignPaymentRequest{OrderID: "upop-order-1"}}
+	_, err = decodeGRPCServicePaymentRequest(ctx, &pb.PaymentRequest{ServiceParams: foreign})
+	assert.True(t, veritrans.IsInvalidParameter(err))
+
+	serviceType = veritrans.PaymentServiceTypes[veritrans.UPop]
+	req, err = decodeGRPCServicePaymentRequest(ctx, &pb.PaymentRequest{ServiceType: &serviceType, ServiceParams: foreign})
+	assert.Nil(t, err)
+	assert.Equal(t, serviceType, req.(endpoint.PaymentRequest).ServiceType)
+
+	// the withCapture of the request applies to the saison params which leave it out
+	withCapture := "true"
+	req, err = decodeGRPCServicePaymentRequest(ctx, &pb.PaymentRequest{
+		WithCapture:   &withCapture,
+		ServiceParams: &pb.PaymentRequest_Saison{Saison: &pb.SaisonPaymentRequest{OrderID: "saison-order-1"}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, withCapture, req.(endpoint.PaymentRequest).Params.WithCapture)
+
+	// the cvs params do not capture
+	req, err = decodeGRPCServicePaymentRequest(ctx, &pb.PaymentRequest{WithCapture: &withCapture, ServiceParams: cvs})
+	assert.Nil(t, err)
+	assert.Empty(t, req.(endpoint.PaymentRequest).Params.WithCapture)
+
+	// the request without service params keeps its service type
+	req, err = decodeGRPCServicePaymentRequest(ctx, &pb.PaymentRequest{ServiceType: &serviceType, OrderID: "upop-order-1"})
+	assert.Nil(t, err)
+	assert.Equal(t, serviceType, req.(endpoint.PaymentRequest).ServiceType)
+}
@@ -3,85 +3,402 @@ package transport
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 
 	"github.com/david1992121/veritrans-microservice/internal/veritrans"
 	"github.com/david1992121/veritrans-microservice/pkg"
 	"github.com/david1992121/veritrans-microservice/pkg/endpoint"
 
+	kitendpoint "github.com/go-kit/kit/endpoint"
 	httptransport "github.com/go-kit/kit/transport/http"
 	"github.com/go-kit/log"
 )
 
 // GetHTTPHandler returns the handler
 func GetHTTPHandler(logger log.Logger) http.Handler {
-	service := pkg.NewLoggingMiddleware(logger, pkg.NewService(pkg.GetServiceConfig()))
-	eps := endpoint.NewEndpointSet(service)
-	return NewHTTPHandler(eps)
+	return NewHTTPHandler(getEndpointSet(logger))
+}
+
+// Get the endpoints of the service configured by the environment variables
+func getEndpointSet(logger log.Logger) endpoint.Set {
+	serviceConfig := pkg.GetServiceConfig()
+	serviceConfig.ConnectionConfig.Logger = log.With(logger, "component", "veritrans")
+	service := pkg.NewLoggingMiddleware(logger, pkg.NewInstrumentingMiddleware(pkg.GetMetrics(), pkg.NewTracingMiddleware(pkg.NewService(serviceConfig))))
+	return endpoint.NewEndpointSet(service).WithResilience(pkg.GetResilienceConfig())
 }
 
 // NewHTTPHandler initializes the http handler
 func NewHTTPHandler(ep endpoint.Set) http.Handler {
 	m := http.NewServeMux()
+	options := []httptransport.ServerOption{
+		httptransport.ServerErrorEncoder(encodeError),
+		httptransport.ServerBefore(startHTTPSpan),
+		httptransport.ServerFinalizer(endHTTPSpan),
+	}
 
 	m.Handle("/mdk/token", httptransport.NewServer(
 		ep.GetMDKTokenEndpoint,
 		decodeHTTPGetMDKTokenRequest,
 		encodeResponse,
+		options...,
 	))
 
 	m.Handle("/account/create", httptransport.NewServer(
 		ep.CreateAccountEndpoint,
 		decodeHTTPAccountRequest,
 		encodeResponse,
+		options...,
 	))
 
 	m.Handle("/account/update", httptransport.NewServer(
 		ep.UpdateAccountEndpoint,
 		decodeHTTPAccountRequest,
 		encodeResponse,
+		options...,
+	))
+
+	m.Handle("/account/get", httptransport.NewServer(
+		ep.GetAccountEndpoint,
+		decodeHTTPAccountRequest,
+		encodeResponse,
+		options...,
+	))
+
+	m.Handle("/account/delete", httptransport.NewServer(
+		ep.DeleteAccountEndpoint,
+		decodeHTTPAccountRequest,
+		encodeResponse,
+		options...,
+	))
+
+	m.Handle("/account/restore", httptransport.NewServer(
+		ep.RestoreAccountEndpoint,
+		decodeHTTPAccountRequest,
+		encodeResponse,
+		options...,
 	))
 
 	m.Handle("/card/create", httptransport.NewServer(
 		ep.CreateCardEndpoint,
 		decodeHTTPAccountRequest,
 		encodeResponse,
+		options...,
 	))
 
 	m.Handle("/card/update", httptransport.NewServer(
 		ep.UpdateCardEndpoint,
 		decodeHTTPAccountRequest,
 		encodeResponse,
+		options...,
 	))
 
 	m.Handle("/card/delete", httptransport.NewServer(
 		ep.DeleteCardEndpoint,
 		decodeHTTPAccountRequest,
 		encodeResponse,
+		options...,
 	))
 
 	m.Handle("/card/get", httptransport.NewServer(
 		ep.GetCardEndpoint,
 		decodeHTTPAccountRequest,
 		encodeResponse,
+		options...,
+	))
+
+	m.Handle("/recurring/create", httptransport.NewServer(
+		ep.CreateRecurringChargeEndpoint,
+		decodeHTTPRecurringChargeRequest,
+		encodeResponse,
+		options...,
+	))
+
+	m.Handle("/recurring/update", httptransport.NewServer(
+		ep.UpdateRecurringChargeEndpoint,
+		decodeHTTPRecurringChargeRequest,
+		encodeResponse,
+		options...,
+	))
+
+	m.Handle("/recurring/delete", httptransport.NewServer(
+		ep.DeleteRecurringChargeEndpoint,
+		decodeHTTPRecurringChargeRequest,
+		encodeResponse,
+		options...,
+	))
+
+	m.Handle("/recurring/get", httptransport.NewServer(
+		ep.GetRecurringChargeEndpoint,
+		decodeHTTPRecurringChargeRequest,
+		encodeResponse,
+		options...,
 	))
 
 	m.Handle("/authorize", httptransport.NewServer(
 		ep.AuthorizeEndpoint,
-		decodeHTTPPaymentRequest,
+		decodeHTTPServicePaymentRequest,
 		encodeResponse,
+		options...,
 	))
 
 	m.Handle("/capture", httptransport.NewServer(
 		ep.CaptureEndpoint,
-		decodeHTTPPaymentRequest,
+		decodeHTTPServicePaymentRequest,
 		encodeResponse,
+		options...,
 	))
 
 	m.Handle("/cancel", httptransport.NewServer(
 		ep.CancelEndpoint,
-		decodeHTTPPaymentRequest,
+		decodeHTTPServicePaymentRequest,
+		encodeResponse,
+		options...,
+	))
+
+	m.Handle("/payment/card/pay-with-token", httptransport.NewServer(
+		ep.PayWithTokenEndpoint,
+		decodeHTTPTokenPaymentRequest,
+		encodeResponse,
+		options...,
+	))
+
+	m.Handle("/payment/card/reauthorize", httptransport.NewServer(
+		ep.ReAuthorizeEndpoint,
+		decodeHTTPReAuthorizeRequest,
+		encodeResponse,
+		options...,
+	))
+
+	m.Handle("/payment/card/partial-capture", httptransport.NewServer(
+		ep.PartialCaptureEndpoint,
+		decodeHTTPPaymentRequest(veritrans.PayCard),
+		encodeResponse,
+		options...,
+	))
+
+	m.Handle("/payment/card/partial-cancel", httptransport.NewServer(
+		ep.PartialCancelEndpoint,
+		decodeHTTPPaymentRequest(veritrans.PayCard),
+		encodeResponse,
+		options...,
+	))
+
+	m.Handle("/payment/cvs/authorize", httptransport.NewServer(
+		ep.AuthorizeEndpoint,
+		decodeHTTPPaymentRequest(veritrans.CVS),
+		encodeResponse,
+		options...,
+	))
+
+	m.Handle("/payment/cvs/cancel", httptransport.NewServer(
+		ep.CancelEndpoint,
+		decodeHTTPPaymentRequest(veritrans.CVS),
+		encodeResponse,
+		options...,
+	))
+
+	m.Handle("/payment/bank/authorize", httptransport.NewServer(
+		ep.AuthorizeEndpoint,
+		decodeHTTPPaymentRequest(veritrans.Bank),
+		encodeResponse,
+		options...,
+	))
+
+	m.Handle("/payment/bank/cancel", httptransport.NewServer(
+		ep.CancelEndpoint,
+		decodeHTTPPaymentRequest(veritrans.Bank),
+		encodeResponse,
+		options...,
+	))
+
+	m.Handle("/payment/mpi/authorize", httptransport.NewServer(
+		ep.AuthorizeEndpoint,
+		decodeHTTPPaymentRequest(veritrans.MPI),
+		encodeResponse,
+		options...,
+	))
+
+	m.Handle("/payment/mpi/callback", httptransport.NewServer(
+		ep.CompleteEndpoint,
+		decodeHTTPCallbackRequest(veritrans.MPI),
+		encodeResponse,
+		options...,
+	))
+
+	m.Handle("/payment/paypal/authorize", httptransport.NewServer(
+		ep.AuthorizeEndpoint,
+		decodeHTTPPaymentRequest(veritrans.Paypal),
+		encodeResponse,
+		options...,
+	))
+
+	m.Handle("/payment/paypal/capture", httptransport.NewServer(
+		ep.CaptureEndpoint,
+		decodeHTTPPaymentRequest(veritrans.Paypal),
+		encodeResponse,
+		options...,
+	))
+
+	m.Handle("/payment/paypal/cancel", httptransport.NewServer(
+		ep.CancelEndpoint,
+		decodeHTTPPaymentRequest(veritrans.Paypal),
+		encodeResponse,
+		options...,
+	))
+
+	m.Handle("/payment/paypal/partial-capture", httptransport.NewServer(
+		ep.PartialCaptureEndpoint,
+		decodeHTTPPaymentRequest(veritrans.Paypal),
+		encodeResponse,
+		options...,
+	))
+
+	m.Handle("/payment/paypal/partial-cancel", httptransport.NewServer(
+		ep.PartialCancelEndpoint,
+		decodeHTTPPaymentRequest(veritrans.Paypal),
+		encodeResponse,
+		options...,
+	))
+
+	m.Handle("/payment/paypal/callback", httptransport.NewServer(
+		ep.CompleteEndpoint,
+		decodeHTTPReturnRequest(veritrans.Paypal),
+		encodeResponse,
+		options...,
+	))
+
+	m.Handle("/payment/alipay/authorize", httptransport.NewServer(
+		ep.AuthorizeEndpoint,
+		decodeHTTPPaymentRequest(veritrans.Alipay),
+		encodeResponse,
+		options...,
+	))
+
+	m.Handle("/payment/alipay/refund", httptransport.NewServer(
+		ep.RefundEndpoint,
+		decodeHTTPPaymentRequest(veritrans.Alipay),
+		encodeResponse,
+		options...,
+	))
+
+	m.Handle("/payment/alipay/callback", httptransport.NewServer(
+		ep.CompleteEndpoint,
+		decodeHTTPReturnRequest(veritrans.Alipay),
+		encodeResponse,
+		options...,
+	))
+
+	m.Handle("/payment/upop/authorize", httptransport.NewServer(
+		ep.AuthorizeEndpoint,
+		decodeHTTPPaymentRequest(veritrans.UPop),
+		encodeResponse,
+		options...,
+	))
+
+	m.Handle("/payment/upop/refund", httptransport.NewServer(
+		ep.RefundEndpoint,
+		decodeHTTPPaymentRequest(veritrans.UPop),
 		encodeResponse,
+		options...,
+	))
+
+	m.Handle("/payment/upop/callback", httptransport.NewServer(
+		ep.CompleteEndpoint,
+		decodeHTTPReturnRequest(veritrans.UPop),
+		encodeResponse,
+		options...,
+	))
+
+	m.Handle("/payment/carrier/authorize", httptransport.NewServer(
+		ep.AuthorizeEndpoint,
+		decodeHTTPPaymentRequest(veritrans.Carrier),
+		encodeResponse,
+		options...,
+	))
+
+	m.Handle("/payment/carrier/capture", httptransport.NewServer(
+		ep.CaptureEndpoint,
+		decodeHTTPPaymentRequest(veritrans.Carrier),
+		encodeResponse,
+		options...,
+	))
+
+	m.Handle("/payment/carrier/cancel", httptransport.NewServer(
+		ep.CancelEndpoint,
+		decodeHTTPPaymentRequest(veritrans.Carrier),
+		encodeResponse,
+		options...,
+	))
+
+	m.Handle("/payment/carrier/partial-capture", httptransport.NewServer(
+		ep.PartialCaptureEndpoint,
+		decodeHTTPPaymentRequest(veritrans.Carrier),
+		encodeResponse,
+		options...,
+	))
+
+	m.Handle("/payment/carrier/partial-cancel", httptransport.NewServer(
+		ep.PartialCancelEndpoint,
+		decodeHTTPPaymentRequest(veritrans.Carrier),
+		encodeResponse,
+		options...,
+	))
+
+	m.Handle("/payment/carrier/callback", httptransport.NewServer(
+		ep.CompleteEndpoint,
+		decodeHTTPReturnRequest(veritrans.Carrier),
+		encodeResponse,
+		options...,
+	))
+
+	m.Handle("/payment/em/authorize", httptransport.NewServer(
+		ep.AuthorizeEndpoint,
+		decodeHTTPPaymentRequest(veritrans.EM),
+		encodeResponse,
+		options...,
+	))
+
+	m.Handle("/payment/em/cancel", httptransport.NewServer(
+		ep.CancelEndpoint,
+		decodeHTTPPaymentRequest(veritrans.EM),
+		encodeResponse,
+		options...,
+	))
+
+	m.Handle("/payment/em/refund", httptransport.NewServer(
+		ep.RefundEndpoint,
+		decodeHTTPPaymentRequest(veritrans.EM),
+		encodeResponse,
+		options...,
+	))
+
+	m.Handle("/payment/saison/authorize", httptransport.NewServer(
+		ep.AuthorizeEndpoint,
+		decodeHTTPPaymentRequest(veritrans.Saison),
+		encodeResponse,
+		options...,
+	))
+
+	m.Handle("/payment/saison/capture", httptransport.NewServer(
+		ep.CaptureEndpoint,
+		decodeHTTPPaymentRequest(veritrans.Saison),
+		encodeResponse,
+		options...,
+	))
+
+	m.Handle("/payment/saison/cancel", httptransport.NewServer(
+		ep.CancelEndpoint,
+		decodeHTTPPaymentRequest(veritrans.Saison),
+		encodeResponse,
+		options...,
+	))
+
+	m.Handle("/payment/search", httptransport.NewServer(
+		ep.SearchOrdersEndpoint,
+		decodeHTTPSearchRequest,
+		encodeResponse,
+		options...,
 	))
 
 	return m
@@ -105,8 +422,76 @@ func decodeHTTPAccountRequest(_ context.Context, r *http.Request) (interface{},
 	return req, nil
 }
 
-func decodeHTTPPaymentRequest(_ context.Context, r *http.Request) (interface{}, error) {
-	var req veritrans.Params
+// Decode the payment request of the service bound to the route
+func decodeHTTPPaymentRequest(serviceType veritrans.PaymentServiceType) httptransport.DecodeRequestFunc {
+	return func(_ context.Context, r *http.Request) (interface{}, error) {
+		var req veritrans.Params
+		err := json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			return nil, err
+		}
+		return endpoint.PaymentRequest{ServiceType: serviceType.String(), Params: req}, nil
+	}
+}
+
+func decodeHTTPServicePaymentRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req endpoint.PaymentRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeHTTPTokenPaymentRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req endpoint.TokenPaymentRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeHTTPReAuthorizeRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req endpoint.ReAuthorizeRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeHTTPRecurringChargeRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req endpoint.RecurringChargeRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// Decode the form posted by the 3-D secure ACS
+func decodeHTTPCallbackRequest(serviceType veritrans.PaymentServiceType) httptransport.DecodeRequestFunc {
+	return func(_ context.Context, r *http.Request) (interface{}, error) {
+		if err := r.ParseForm(); err != nil {
+			return nil, err
+		}
+		return endpoint.CallbackRequest{ServiceType: serviceType.String(), Values: r.PostForm}, nil
+	}
+}
+
+// Decode the query of the customer returning from the redirect payment
+func decodeHTTPReturnRequest(serviceType veritrans.PaymentServiceType) httptransport.DecodeRequestFunc {
+	return func(_ context.Context, r *http.Request) (interface{}, error) {
+		if err := r.ParseForm(); err != nil {
+			return nil, err
+		}
+		return endpoint.CallbackRequest{ServiceType: serviceType.String(), Values: r.Form}, nil
+	}
+}
+
+func decodeHTTPSearchRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req endpoint.SearchRequest
 	err := json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
 		return nil, err
@@ -115,5 +500,52 @@ func decodeHTTPPaymentRequest(_ context.Context, r *http.Request) (interface{},
 }
 
 func encodeResponse(_ context.Context, w http.ResponseWriter, response interface{}) error {
+	if f, ok := response.(kitendpoint.Failer); ok && f.Failed() != nil {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(getHTTPStatus(f.Failed()))
+	}
 	return json.NewEncoder(w).Encode(response)
 }
+
+func encodeError(_ context.Context, err error, w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(getHTTPStatus(err))
+	json.NewEncoder(w).Encode(map[string]string{"err": err.Error()})
+}
+
+// Get the http status code from the error category
+func getHTTPStatus(err error) int {
+	switch {
+	case errors.Is(err, endpoint.ErrUpstreamUnavailable):
+		return http.StatusServiceUnavailable
+	case errors.Is(err, endpoint.ErrRateLimited):
+		return http.StatusTooManyRequests
+	}
+
+	var validationErr *veritrans.ValidationError
+	if errors.As(err, &validationErr) {
+		return http.StatusBadRequest
+	}
+
+	var apiErr *veritrans.APIError
+	if !errors.As(err, &apiErr) {
+		return http.StatusInternalServerError
+	}
+
+	switch apiErr.Category() {
+	case veritrans.CategoryCardDeclined:
+		return http.StatusPaymentRequired
+	case veritrans.CategoryInvalidParameter:
+		return http.StatusBadRequest
+	case veritrans.CategoryDuplicateOrder, veritrans.CategoryAccountExists:
+		return http.StatusConflict
+	case veritrans.CategoryAccountNotFound:
+		return http.StatusNotFound
+	case veritrans.CategoryRetryable:
+		return http.StatusServiceUnavailable
+	case veritrans.CategorySystemError:
+		return http.StatusBadGateway
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,71 @@
+package transport
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/david1992121/veritrans-microservice/internal/veritrans"
+	"github.com/david1992121/veritrans-microservice/pkg/endpoint"
+	assert "github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetErrorStatus(t *testing.T) {
+	testCases := []struct {
+		err        error
+		httpStatus int
+		grpcCode   codes.Code
+	}{
+		{&veritrans.ValidationError{Field: "orderId", Message: "required"}, http.StatusBadRequest, codes.InvalidArgument},
+		{&veritrans.APIError{VResultCode: "MA01000000000000"}, http.StatusBadRequest, codes.InvalidArgument},
+		{&veritrans.APIError{VResultCode: "AG33000000000000"}, http.StatusPaymentRequired, codes.FailedPrecondition},
+		{&veritrans.APIError{VResultCode: "NH18000000000000"}, http.StatusConflict, codes.AlreadyExists},
+		{&veritrans.APIError{VResultCode: "NC11000000000000"}, http.StatusNotFound, codes.NotFound},
+		{&veritrans.APIError{VResultCode: "NE01000000000000"}, http.StatusServiceUnavailable, codes.Unavailable},
+		{&veritrans.APIError{VResultCode: "NF01000000000000"}, http.StatusBadGateway, codes.Internal},
+		{&veritrans.APIError{VResultCode: "XX99000000000000"}, http.StatusInternalServerError, codes.Unknown},
+		{fmt.Errorf("unexpected"), http.StatusInternalServerError, codes.Unknown},
+		{endpoint.ErrRateLimited, http.StatusTooManyRequests, codes.ResourceExhausted},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.httpStatus, getHTTPStatus(testCase.err), testCase.err.Error())
+		assert.Equal(t, testCase.grpcCode, status.Code(getGRPCError(testCase.err)), testCase.err.Error())
+	}
+}
+
+func TestErrorCategoryMapping(t *testing.T) {
+	resultCodes := map[veritrans.ErrorCategory]string{
+		veritrans.CategoryUnknown:          "XX99000000000000",
+		veritrans.CategoryCardDeclined:     "AG33000000000000",
+		veritrans.CategoryInvalidParameter: "MA01000000000000",
+		veritrans.CategoryDuplicateOrder:   "NH18000000000000",
+		veritrans.CategoryAccountNotFound:  "NC11000000000000",
+		veritrans.CategoryAccountExists:    "NC12000000000000",
+		veritrans.CategorySystemError:      "NF01000000000000",
+		veritrans.CategoryRetryable:        "NE01000000000000",
+	}
+	assert.Equal(t, len(veritrans.ErrorCategories), len(resultCodes))
+
+	// the categories told apart by the http status are told apart by the grpc code, and the other way around
+	grpcCodes := map[int]codes.Code{}
+	httpStatuses := map[codes.Code]int{}
+	for category, resultCode := range resultCodes {
+		err := &veritrans.APIError{VResultCode: resultCode}
+		assert.Equal(t, category, err.Category())
+
+		httpStatus := getHTTPStatus(err)
+		grpcCode := status.Code(getGRPCError(err))
+		if code, ok := grpcCodes[httpStatus]; ok {
+			assert.Equal(t, code, grpcCode, category.String())
+		}
+		if statusCode, ok := httpStatuses[grpcCode]; ok {
+			assert.Equal(t, statusCode, httpStatus, category.String())
+		}
+		grpcCodes[httpStatus] = grpcCode
+		httpStatuses[grpcCode] = httpStatus
+	}
+	assert.NotEqual(t, grpcCodes[http.StatusBadGateway], grpcCodes[http.StatusServiceUnavailable])
+}
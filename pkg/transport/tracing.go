@@ -0,0 +1,90 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.7.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const tracerName = "github.com/david1992121/veritrans-microservice/pkg/transport"
+
+// Start the server span of the http request continuing the trace of the traceparent header
+func startHTTPSpan(ctx context.Context, r *http.Request) context.Context {
+	ctx = otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(r.Header))
+	ctx, _ = otel.Tracer(tracerName).Start(ctx, "HTTP "+r.URL.Path,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			semconv.HTTPMethodKey.String(r.Method),
+			semconv.HTTPTargetKey.String(r.URL.Path),
+		),
+	)
+	return ctx
+}
+
+// End the server span of the http request
+func endHTTPSpan(ctx context.Context, code int, r *http.Request) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(semconv.HTTPStatusCodeKey.Int(code))
+	span.SetStatus(semconv.SpanStatusFromHTTPStatusCodeAndSpanKind(code, trace.SpanKindServer))
+	span.End()
+}
+
+// Start the server span of the grpc request continuing the trace of the metadata
+func startGRPCSpan(ctx context.Context, md metadata.MD) context.Context {
+	ctx = otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+	method, _ := ctx.Value(grpctransport.ContextKeyRequestMethod).(string)
+	ctx, _ = otel.Tracer(tracerName).Start(ctx, "gRPC "+method,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			semconv.RPCSystemKey.String("grpc"),
+			semconv.RPCMethodKey.String(method),
+		),
+	)
+	return ctx
+}
+
+// End the server span of the grpc request
+func endGRPCSpan(ctx context.Context, err error) {
+	span := trace.SpanFromContext(ctx)
+	code := status.Code(err)
+	span.SetAttributes(semconv.RPCGRPCStatusCodeKey.Int64(int64(code)))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, code.String())
+	}
+	span.End()
+}
+
+// metadataCarrier carries the trace context in the grpc metadata
+type metadataCarrier metadata.MD
+
+// Get returns the first value of the key
+func (m metadataCarrier) Get(key string) string {
+	values := metadata.MD(m).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// Set sets the value of the key
+func (m metadataCarrier) Set(key string, value string) {
+	metadata.MD(m).Set(key, value)
+}
+
+// Keys returns the keys of the metadata
+func (m metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
@@ -0,0 +1,74 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/david1992121/veritrans-microservice/api/pb"
+	"github.com/david1992121/veritrans-microservice/pkg/endpoint"
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	testTraceID     = "4bf92f3577b34da6a3ce929d0e0e4736"
+	testTraceParent = "00-" + testTraceID + "-00f067aa0ba902b7-01"
+)
+
+// Record the spans to the in-memory exporter
+func getTestExporter() *tracetest.InMemoryExporter {
+	exporter := tracetest.NewInMemoryExporter()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	return exporter
+}
+
+// Get the endpoints which return empty search results
+func getTestEndpoints() endpoint.Set {
+	return endpoint.Set{
+		SearchOrdersEndpoint: func(ctx context.Context, request interface{}) (interface{}, error) {
+			return endpoint.SearchResponse{}, nil
+		},
+	}
+}
+
+func TestHTTPTracing(t *testing.T) {
+	exporter := getTestExporter()
+	handler := NewHTTPHandler(getTestEndpoints())
+
+	req := httptest.NewRequest(http.MethodPost, "/payment/search", strings.NewReader(`{"orderId":"order-1"}`))
+	req.Header.Set("traceparent", testTraceParent)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 1)
+	assert.Equal(t, "HTTP /payment/search", spans[0].Name)
+	assert.Equal(t, testTraceID, spans[0].SpanContext.TraceID().String())
+	assert.True(t, spans[0].Parent.IsRemote())
+}
+
+func TestGRPCTracing(t *testing.T) {
+	exporter := getTestExporter()
+	server := NewGRPCServer(getTestEndpoints())
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("traceparent", testTraceParent))
+	ctx = context.WithValue(ctx, grpctransport.ContextKeyRequestMethod, "/pb.Veritrans/SearchOrders")
+	_, err := server.SearchOrders(ctx, &pb.SearchRequest{})
+	assert.NoError(t, err)
+
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 1)
+	assert.Equal(t, "gRPC /pb.Veritrans/SearchOrders", spans[0].Name)
+	assert.Equal(t, testTraceID, spans[0].SpanContext.TraceID().String())
+	assert.True(t, spans[0].Parent.IsRemote())
+}
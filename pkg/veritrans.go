@@ -1,7 +1,15 @@
 package pkg
 
 import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/url"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/david1992121/veritrans-microservice/internal/veritrans"
 )
@@ -14,9 +22,11 @@ type ServiceConfig struct {
 
 // GetServiceConfig initializes the service configuration
 func GetServiceConfig() *ServiceConfig {
+	httpClient := veritrans.NewHTTPClient(GetClientConfig())
 	mdkConfig := veritrans.MDKConfig{
-		APIURL:   os.Getenv("MDK_API_URL"),
-		APIToken: os.Getenv("MDK_API_TOKEN"),
+		APIURL:     os.Getenv("MDK_API_URL"),
+		APIToken:   os.Getenv("MDK_API_TOKEN"),
+		HTTPClient: httpClient,
 	}
 	connectionConfig := veritrans.ConnectionConfig{
 		MerchantCCID:     os.Getenv("MERCHANT_CCID"),
@@ -26,6 +36,11 @@ func GetServiceConfig() *ServiceConfig {
 		SearchAPIURL:     os.Getenv("SEARCH_API_URL"),
 		TxnVersion:       os.Getenv("TXN_VERSION"),
 		DummyRequest:     os.Getenv("DUMMY_REQUEST"),
+		HTTPClient:       httpClient,
+		RetryPolicy:      GetRetryPolicy(),
+	}
+	if serviceTypes := os.Getenv("ENABLED_SERVICE_TYPES"); serviceTypes != "" {
+		connectionConfig.EnabledServiceTypes = strings.Split(serviceTypes, ",")
 	}
 
 	serviceConfig := &ServiceConfig{
@@ -36,64 +51,318 @@ func GetServiceConfig() *ServiceConfig {
 	return serviceConfig
 }
 
+// GetClientConfig initializes the http client configuration from the environment
+func GetClientConfig() veritrans.ClientConfig {
+	config := veritrans.DefaultClientConfig()
+	config.ConnectTimeout = envDuration("HTTP_CONNECT_TIMEOUT", config.ConnectTimeout)
+	config.ReadTimeout = envDuration("HTTP_READ_TIMEOUT", config.ReadTimeout)
+	config.Timeout = envDuration("HTTP_TIMEOUT", config.Timeout)
+	config.KeepAlive = envDuration("HTTP_KEEP_ALIVE", config.KeepAlive)
+	config.MaxIdleConns = envInt("HTTP_MAX_IDLE_CONNS", config.MaxIdleConns)
+	config.MaxIdleConnsPerHost = envInt("HTTP_MAX_IDLE_CONNS_PER_HOST", config.MaxIdleConnsPerHost)
+	config.MaxConnsPerHost = envInt("HTTP_MAX_CONNS_PER_HOST", config.MaxConnsPerHost)
+	config.IdleConnTimeout = envDuration("HTTP_IDLE_CONN_TIMEOUT", config.IdleConnTimeout)
+	config.TLSHandshakeTimeout = envDuration("HTTP_TLS_HANDSHAKE_TIMEOUT", config.TLSHandshakeTimeout)
+	config.InsecureSkipVerify = envBool("HTTP_TLS_INSECURE_SKIP_VERIFY", config.InsecureSkipVerify)
+	if os.Getenv("HTTP_TLS_MIN_VERSION") == "1.3" {
+		config.TLSMinVersion = tls.VersionTLS13
+	}
+	return config
+}
+
+// GetRetryPolicy initializes the retry policy from the environment
+func GetRetryPolicy() veritrans.RetryPolicy {
+	policy := veritrans.DefaultRetryPolicy()
+	policy.MaxRetries = envInt("RETRY_MAX_RETRIES", policy.MaxRetries)
+	policy.BaseDelay = envDuration("RETRY_BASE_DELAY", policy.BaseDelay)
+	policy.MaxDelay = envDuration("RETRY_MAX_DELAY", policy.MaxDelay)
+	if codes := os.Getenv("RETRY_CODES"); codes != "" {
+		policy.RetryableCodes = strings.Split(codes, ",")
+	}
+	return policy
+}
+
 type veritransService struct {
 	MDKService     *veritrans.MDKService
 	AccountService *veritrans.AccountService
 	PaymentService *veritrans.PaymentService
+
+	// the error of the payment service initialization, returned by the payment methods
+	paymentErr error
 }
 
 // NewService initializes the veritrans service
 func NewService(config *ServiceConfig) Service {
 	mdkService := veritrans.NewMDKService(config.MDKConfig)
 
-	paymentService, _ := veritrans.NewPaymentService(config.ConnectionConfig)
+	paymentService, err := veritrans.NewPaymentService(config.ConnectionConfig)
 	accountService := veritrans.NewAccountService(config.ConnectionConfig)
 	return &veritransService{
 		MDKService:     mdkService,
 		AccountService: accountService,
 		PaymentService: paymentService,
+		paymentErr:     err,
+	}
+}
+
+// Get the payment service, or the error if it is not initialized
+func (v *veritransService) getPaymentService() (*veritrans.PaymentService, error) {
+	if v.PaymentService == nil {
+		if v.paymentErr != nil {
+			return nil, v.paymentErr
+		}
+		return nil, errors.New("payment service not initialized")
+	}
+	return v.PaymentService, nil
+}
+
+func (v *veritransService) GetMDKToken(ctx context.Context, cardInfo *veritrans.ClientCardInfo) (string, error) {
+	return v.MDKService.GetCardToken(ctx, cardInfo)
+}
+
+func (v *veritransService) CreateAccount(ctx context.Context, accountParam *veritrans.AccountParam) (*veritrans.Account, error) {
+	return v.AccountService.CreateAccount(ctx, accountParam)
+}
+
+func (v *veritransService) UpdateAccount(ctx context.Context, accountParam *veritrans.AccountParam) (*veritrans.Account, error) {
+	return v.AccountService.UpdateAccount(ctx, accountParam)
+}
+
+func (v *veritransService) GetAccount(ctx context.Context, accountParam *veritrans.AccountParam) (*veritrans.Account, error) {
+	return v.AccountService.GetAccount(ctx, accountParam)
+}
+
+func (v *veritransService) DeleteAccount(ctx context.Context, accountParam *veritrans.AccountParam) (*veritrans.Account, error) {
+	return v.AccountService.DeleteAccount(ctx, accountParam)
+}
+
+func (v *veritransService) RestoreAccount(ctx context.Context, accountParam *veritrans.AccountParam) (*veritrans.Account, error) {
+	return v.AccountService.RestoreAccount(ctx, accountParam)
+}
+
+func (v *veritransService) CreateCard(ctx context.Context, accountParam *veritrans.AccountParam) (*veritrans.Account, error) {
+	return v.AccountService.CreateCard(ctx, accountParam)
+}
+
+func (v *veritransService) UpdateCard(ctx context.Context, accountParam *veritrans.AccountParam) (*veritrans.Account, error) {
+	return v.AccountService.UpdateCard(ctx, accountParam)
+}
+
+func (v *veritransService) DeleteCard(ctx context.Context, accountParam *veritrans.AccountParam) (*veritrans.Account, error) {
+	return v.AccountService.DeleteCard(ctx, accountParam)
+}
+
+func (v *veritransService) GetCard(ctx context.Context, accountParam *veritrans.AccountParam) (*veritrans.Account, error) {
+	return v.AccountService.GetCard(ctx, accountParam)
+}
+
+func (v *veritransService) CreateRecurringCharge(ctx context.Context, accountParam *veritrans.AccountParam) (*veritrans.Account, error) {
+	return v.AccountService.CreateRecurringCharge(ctx, accountParam)
+}
+
+func (v *veritransService) UpdateRecurringCharge(ctx context.Context, accountParam *veritrans.AccountParam) (*veritrans.Account, error) {
+	return v.AccountService.UpdateRecurringCharge(ctx, accountParam)
+}
+
+func (v *veritransService) DeleteRecurringCharge(ctx context.Context, accountParam *veritrans.AccountParam) (*veritrans.Account, error) {
+	return v.AccountService.DeleteRecurringCharge(ctx, accountParam)
+}
+
+func (v *veritransService) GetRecurringCharge(ctx context.Context, accountParam *veritrans.AccountParam) (*veritrans.Account, error) {
+	return v.AccountService.GetRecurringCharge(ctx, accountParam)
+}
+
+// paymentFunc is the payment operation of a service
+type paymentFunc func(veritrans.PaymentService, context.Context, *veritrans.Params) (*veritrans.Result, error)
+
+// callbackFunc completes the redirect payment of a service from the callback
+type callbackFunc func(veritrans.PaymentService, context.Context, url.Values) (*veritrans.Result, error)
+
+// The operations supported by each payment service
+var (
+	authorizeFuncs = map[veritrans.PaymentServiceType]paymentFunc{
+		veritrans.PayCard: cardFunc(veritrans.PaymentService.Authorize),
+		veritrans.MPI:     veritrans.PaymentService.AuthorizeMPI,
+		veritrans.CVS:     veritrans.PaymentService.AuthorizeCVS,
+		veritrans.EM:      veritrans.PaymentService.AuthorizeEM,
+		veritrans.Bank:    veritrans.PaymentService.AuthorizeBank,
+		veritrans.UPop:    veritrans.PaymentService.AuthorizeUPop,
+		veritrans.Paypal:  veritrans.PaymentService.AuthorizePaypal,
+		veritrans.Saison:  veritrans.PaymentService.AuthorizeSaison,
+		veritrans.Alipay:  veritrans.PaymentService.AuthorizeAlipay,
+		veritrans.Carrier: veritrans.PaymentService.AuthorizeCarrier,
+	}
+	captureFuncs = map[veritrans.PaymentServiceType]paymentFunc{
+		veritrans.PayCard: cardFunc(veritrans.PaymentService.Capture),
+		veritrans.Paypal:  veritrans.PaymentService.CapturePaypal,
+		veritrans.Saison:  veritrans.PaymentService.CaptureSaison,
+		veritrans.Carrier: veritrans.PaymentService.CaptureCarrier,
+	}
+	// the foreign payments are captured on the authorization and cancelled by the refund
+	cancelFuncs = map[veritrans.PaymentServiceType]paymentFunc{
+		veritrans.PayCard: cardFunc(veritrans.PaymentService.Cancel),
+		veritrans.CVS:     veritrans.PaymentService.CancelCVS,
+		veritrans.EM:      veritrans.PaymentService.CancelEM,
+		veritrans.Bank:    veritrans.PaymentService.CancelBank,
+		veritrans.UPop:    veritrans.PaymentService.RefundUPop,
+		veritrans.Paypal:  veritrans.PaymentService.CancelPaypal,
+		veritrans.Saison:  veritrans.PaymentService.CancelSaison,
+		veritrans.Alipay:  veritrans.PaymentService.RefundAlipay,
+		veritrans.Carrier: veritrans.PaymentService.CancelCarrier,
+	}
+	refundFuncs = map[veritrans.PaymentServiceType]paymentFunc{
+		veritrans.EM:     veritrans.PaymentService.RefundEM,
+		veritrans.UPop:   veritrans.PaymentService.RefundUPop,
+		veritrans.Alipay: veritrans.PaymentService.RefundAlipay,
 	}
+	completeFuncs = map[veritrans.PaymentServiceType]callbackFunc{
+		veritrans.MPI:     veritrans.PaymentService.CompleteMPI,
+		veritrans.UPop:    veritrans.PaymentService.CompleteUPop,
+		veritrans.Paypal:  veritrans.PaymentService.CompletePaypal,
+		veritrans.Alipay:  veritrans.PaymentService.CompleteAlipay,
+		veritrans.Carrier: veritrans.PaymentService.CompleteCarrier,
+	}
+)
+
+func (v *veritransService) Authorize(ctx context.Context, param *veritrans.Params, serviceType veritrans.PaymentServiceType) (*veritrans.Result, error) {
+	return v.executePayment(ctx, "authorize", authorizeFuncs, param, serviceType)
 }
 
-func (v *veritransService) GetMDKToken(cardInfo *veritrans.ClientCardInfo) (string, error) {
-	return v.MDKService.GetCardToken(cardInfo)
+func (v *veritransService) Capture(ctx context.Context, param *veritrans.Params, serviceType veritrans.PaymentServiceType) (*veritrans.Result, error) {
+	return v.executePayment(ctx, "capture", captureFuncs, param, serviceType)
 }
 
-func (v *veritransService) CreateAccount(accountParam *veritrans.AccountParam) (*veritrans.Account, error) {
-	return v.AccountService.CreateAccount(accountParam)
+func (v *veritransService) Cancel(ctx context.Context, param *veritrans.Params, serviceType veritrans.PaymentServiceType) (*veritrans.Result, error) {
+	return v.executePayment(ctx, "cancel", cancelFuncs, param, serviceType)
 }
 
-func (v *veritransService) UpdateAccount(accountParam *veritrans.AccountParam) (*veritrans.Account, error) {
-	return v.AccountService.UpdateAccount(accountParam)
+func (v *veritransService) Refund(ctx context.Context, param *veritrans.Params, serviceType veritrans.PaymentServiceType) (*veritrans.Result, error) {
+	return v.executePayment(ctx, "refund", refundFuncs, param, serviceType)
 }
 
-func (v *veritransService) CreateCard(accountParam *veritrans.AccountParam) (*veritrans.Account, error) {
-	return v.AccountService.CreateCard(accountParam)
+func (v *veritransService) Complete(ctx context.Context, values url.Values, serviceType veritrans.PaymentServiceType) (*veritrans.Result, error) {
+	complete, ok := completeFuncs[serviceType]
+	if !ok {
+		return nil, getUnsupportedError("callback", serviceType)
+	}
+	paymentService, err := v.getPaymentService()
+	if err != nil {
+		return nil, err
+	}
+	if !paymentService.IsEnabled(serviceType) {
+		return nil, getDisabledError(serviceType)
+	}
+	return complete(*paymentService, ctx, values)
 }
 
-func (v *veritransService) UpdateCard(accountParam *veritrans.AccountParam) (*veritrans.Account, error) {
-	return v.AccountService.UpdateCard(accountParam)
+// Execute the operation of the payment service if the service supports it and the merchant enables it
+func (v *veritransService) executePayment(ctx context.Context, operation string, funcs map[veritrans.PaymentServiceType]paymentFunc, param *veritrans.Params, serviceType veritrans.PaymentServiceType) (*veritrans.Result, error) {
+	execute, ok := funcs[serviceType]
+	if !ok {
+		return nil, getUnsupportedError(operation, serviceType)
+	}
+	paymentService, err := v.getPaymentService()
+	if err != nil {
+		return nil, err
+	}
+	if !paymentService.IsEnabled(serviceType) {
+		return nil, getDisabledError(serviceType)
+	}
+	return execute(*paymentService, ctx, param)
 }
 
-func (v *veritransService) DeleteCard(accountParam *veritrans.AccountParam) (*veritrans.Account, error) {
-	return v.AccountService.DeleteCard(accountParam)
+// Get the payment operation of the card from the generic operation
+func cardFunc(operation func(veritrans.PaymentService, context.Context, *veritrans.Params, veritrans.PaymentServiceType) (*veritrans.Result, error)) paymentFunc {
+	return func(pay veritrans.PaymentService, ctx context.Context, param *veritrans.Params) (*veritrans.Result, error) {
+		return operation(pay, ctx, param, veritrans.PayCard)
+	}
 }
 
-func (v *veritransService) GetCard(accountParam *veritrans.AccountParam) (*veritrans.Account, error) {
-	return v.AccountService.GetCard(accountParam)
+func (v *veritransService) PartialCapture(ctx context.Context, param *veritrans.Params, serviceType veritrans.PaymentServiceType) (*veritrans.Result, error) {
+	paymentService, err := v.getPaymentService()
+	if err != nil {
+		return nil, err
+	}
+	return paymentService.PartialCapture(ctx, param, serviceType)
 }
 
-func (v *veritransService) Authorize(param *veritrans.Params) error {
-	_, err := v.PaymentService.Authorize(param, veritrans.PaymentServiceType(veritrans.PayCard))
-	return err
+func (v *veritransService) PartialCancel(ctx context.Context, param *veritrans.Params, serviceType veritrans.PaymentServiceType) (*veritrans.Result, error) {
+	paymentService, err := v.getPaymentService()
+	if err != nil {
+		return nil, err
+	}
+	return paymentService.PartialCancel(ctx, param, serviceType)
 }
 
-func (v *veritransService) Capture(param *veritrans.Params) error {
-	_, err := v.PaymentService.Capture(param, veritrans.PaymentServiceType(veritrans.PayCard))
-	return err
+func (v *veritransService) ReAuthorize(ctx context.Context, param *veritrans.Params, cancelOriginal bool) (*veritrans.Result, error) {
+	paymentService, err := v.getPaymentService()
+	if err != nil {
+		return nil, err
+	}
+	return paymentService.ReAuthorize(ctx, param, cancelOriginal)
 }
 
-func (v *veritransService) Cancel(param *veritrans.Params) error {
-	_, err := v.PaymentService.Cancel(param, veritrans.PaymentServiceType(veritrans.PayCard))
-	return err
+func (v *veritransService) PayWithToken(ctx context.Context, param *veritrans.Params) (*veritrans.Result, error) {
+	paymentService, err := v.getPaymentService()
+	if err != nil {
+		return nil, err
+	}
+	return paymentService.PayWithToken(ctx, param)
+}
+
+func (v *veritransService) SearchOrders(ctx context.Context, param *veritrans.Params) (*veritrans.OrderInfos, error) {
+	paymentService, err := v.getPaymentService()
+	if err != nil {
+		return nil, err
+	}
+	result, err := paymentService.Search(ctx, param, veritrans.PaymentServiceType(veritrans.Search))
+	if err != nil {
+		return nil, err
+	}
+	return result.OrderInfos, nil
+}
+
+// Get the error of the operation which the payment service does not support
+func getUnsupportedError(operation string, serviceType veritrans.PaymentServiceType) error {
+	return &veritrans.ValidationError{
+		Field:   "serviceType",
+		Message: fmt.Sprintf("%s is not supported by %s", operation, serviceType),
+	}
+}
+
+// Get the error of the payment service which the merchant does not enable
+func getDisabledError(serviceType veritrans.PaymentServiceType) error {
+	return &veritrans.ValidationError{Field: "serviceType", Message: serviceType.String() + " is not enabled"}
+}
+
+func envDuration(env string, defaultValue time.Duration) time.Duration {
+	value, err := time.ParseDuration(os.Getenv(env))
+	if err != nil {
+		return defaultValue
+	}
+	return value
+}
+
+func envInt(env string, defaultValue int) int {
+	value, err := strconv.Atoi(os.Getenv(env))
+	if err != nil {
+		return defaultValue
+	}
+	return value
+}
+
+func envBool(env string, defaultValue bool) bool {
+	value, err := strconv.ParseBool(os.Getenv(env))
+	if err != nil {
+		return defaultValue
+	}
+	return value
+}
+
+func envFloat(env string, defaultValue float64) float64 {
+	value, err := strconv.ParseFloat(os.Getenv(env), 64)
+	if err != nil {
+		return defaultValue
+	}
+	return value
 }
@@ -0,0 +1,65 @@
+package pkg
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/david1992121/veritrans-microservice/internal/veritrans"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnsupportedServiceType(t *testing.T) {
+	ctx := context.Background()
+	service := &veritransService{
+		PaymentService: &veritrans.PaymentService{
+			Config: veritrans.ConnectionConfig{EnabledServiceTypes: []string{"card", "em"}},
+		},
+	}
+	param := &veritrans.Params{OrderID: "order-1"}
+
+	// the unlisted and out of range services are rejected before any request
+	_, err := service.Authorize(ctx, param, veritrans.Search)
+	assert.True(t, veritrans.IsInvalidParameter(err))
+	assert.Contains(t, err.Error(), "authorize is not supported by search")
+
+	_, err = service.Cancel(ctx, param, veritrans.PaymentServiceType(99))
+	assert.True(t, veritrans.IsInvalidParameter(err))
+	assert.Contains(t, err.Error(), "cancel is not supported by unknown")
+
+	_, err = service.Refund(ctx, param, veritrans.PayCard)
+	assert.True(t, veritrans.IsInvalidParameter(err))
+
+	_, err = service.Complete(ctx, url.Values{}, veritrans.CVS)
+	assert.True(t, veritrans.IsInvalidParameter(err))
+
+	// the supported service is rejected when the merchant does not enable it
+	_, err = service.Authorize(ctx, param, veritrans.Bank)
+	assert.True(t, veritrans.IsInvalidParameter(err))
+	assert.Contains(t, err.Error(), "bank is not enabled")
+}
+
+func TestPaymentServiceNotConfigured(t *testing.T) {
+	ctx := context.Background()
+	service := NewService(&ServiceConfig{})
+	param := &veritrans.Params{OrderID: "order-1", Amount: "100"}
+
+	// the payment methods return the initialization error instead of panicking
+	_, err := service.SearchOrders(ctx, param)
+	assert.EqualError(t, err, "api URL not provided")
+
+	_, err = service.Authorize(ctx, param, veritrans.PayCard)
+	assert.EqualError(t, err, "api URL not provided")
+
+	_, err = service.Complete(ctx, url.Values{}, veritrans.MPI)
+	assert.EqualError(t, err, "api URL not provided")
+
+	_, err = service.PartialCapture(ctx, param, veritrans.PayCard)
+	assert.EqualError(t, err, "api URL not provided")
+
+	_, err = service.ReAuthorize(ctx, param, false)
+	assert.EqualError(t, err, "api URL not provided")
+
+	_, err = service.PayWithToken(ctx, param)
+	assert.EqualError(t, err, "api URL not provided")
+}
@@ -0,0 +1,89 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/david1992121/veritrans-microservice/api/pb"
+	"github.com/david1992121/veritrans-microservice/internal/veritrans"
+	"github.com/david1992121/veritrans-microservice/internal/veritrans/veritranstest"
+	assert "github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestHTTPBank function
+func TestHTTPBank(t *testing.T) {
+	fake := veritranstest.NewServer("test-ccid", "test-password")
+	defer fake.Close()
+	handler, _ := getFakeServers(fake)
+
+	code, paymentRes := postPayment(t, handler, "/payment/bank/authorize",
+		`{"orderId":"bank-order-001","amount":"1000","serviceOptionType":"netbank-pc","name1":"Yamada","name2":"Taro","kana1":"ヤマダ","kana2":"タロウ","payLimit":"20300101"}`)
+	assert.Equal(t, http.StatusOK, code, paymentRes.Err)
+	assert.Equal(t, "58191", paymentRes.Result.ShunoKikanNo)
+	assert.NotEmpty(t, paymentRes.Result.CustomerNo)
+	assert.NotEmpty(t, paymentRes.Result.URL)
+
+	// reject the payer name without katakana
+	code, _ = postPayment(t, handler, "/payment/bank/authorize",
+		`{"orderId":"bank-order-002","amount":"1000","serviceOptionType":"netbank-pc","name1":"Yamada","name2":"Taro","kana1":"yamada","kana2":"taro","payLimit":"20300101"}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	// cancel the payment which is not paid yet
+	code, paymentRes = postPayment(t, handler, "/payment/bank/cancel", `{"orderId":"bank-order-001"}`)
+	assert.Equal(t, http.StatusOK, code, paymentRes.Err)
+	assert.Equal(t, "bank-order-001", paymentRes.Result.OrderID)
+
+	assert.Equal(t, []string{"Authorize/bank", "Cancel/bank"}, fake.Requests())
+}
+
+// TestGRPCBank function
+func TestGRPCBank(t *testing.T) {
+	fake := veritranstest.NewServer("test-ccid", "test-password")
+	defer fake.Close()
+	_, server := getFakeServers(fake)
+	ctx := context.Background()
+	contents, contentsKana := "Order", "オーダー"
+	serviceType := veritrans.PaymentServiceTypes[veritrans.Bank]
+
+	reply, err := server.Authorize(ctx, &pb.PaymentRequest{
+		ServiceType: &serviceType,
+		ServiceParams: &pb.PaymentRequest_Bank{Bank: &pb.BankPaymentRequest{
+			OrderID:           "bank-order-003",
+			Amount:            "1000",
+			ServiceOptionType: "atm",
+			Name1:             "Yamada",
+			Name2:             "Taro",
+			Kana1:             "ヤマダ",
+			Kana2:             "タロウ",
+			PayLimit:          "20300101",
+			Contents:          &contents,
+			ContentsKana:      &contentsKana,
+		}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "123456", reply.Result.GetConfirmNo())
+	assert.Empty(t, reply.Result.GetUrl())
+
+	reply, err = server.Cancel(ctx, &pb.PaymentRequest{ServiceType: &serviceType, OrderID: "bank-order-003"})
+	assert.Nil(t, err)
+	assert.Equal(t, "bank-order-003", reply.Result.OrderID)
+
+	// the contents are required for the atm
+	_, err = server.Authorize(ctx, &pb.PaymentRequest{
+		ServiceType: &serviceType,
+		ServiceParams: &pb.PaymentRequest_Bank{Bank: &pb.BankPaymentRequest{
+			OrderID:           "bank-order-004",
+			Amount:            "1000",
+			ServiceOptionType: "atm",
+			Name1:             "Yamada",
+			Name2:             "Taro",
+			Kana1:             "ヤマダ",
+			Kana2:             "タロウ",
+			PayLimit:          "20300101",
+		}},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
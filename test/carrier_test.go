@@ -0,0 +1,101 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/david1992121/veritrans-microservice/api/pb"
+	"github.com/david1992121/veritrans-microservice/internal/veritrans"
+	"github.com/david1992121/veritrans-microservice/internal/veritrans/veritranstest"
+	assert "github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestHTTPCarrier function
+func TestHTTPCarrier(t *testing.T) {
+	fake := veritranstest.NewServer("test-ccid", "test-password")
+	defer fake.Close()
+	handler, _ := getFakeServers(fake)
+	callbackURL := fmt.Sprintf(foreignCallbackURL, "carrier")
+	authorizeJSON := `{"orderId":"%s","amount":"500","serviceOptionType":"docomo","terminalKind":"%s","itemType":"0",` +
+		`"successUrl":"` + callbackURL + `","cancelUrl":"` + callbackURL + `","errorUrl":"` + callbackURL + `"}`
+
+	// paid on the smart phone
+	code, paymentRes := postPayment(t, handler, "/payment/carrier/authorize", fmt.Sprintf(authorizeJSON, "carrier-order-001", "1"))
+	assert.Equal(t, http.StatusOK, code, paymentRes.Err)
+	assert.NotEmpty(t, paymentRes.Result.RedirectURL)
+
+	code, paymentRes = returnFromForeign(t, handler, "carrier", paymentRes.Result.RedirectURL)
+	assert.Equal(t, http.StatusOK, code, paymentRes.Err)
+	assert.Equal(t, "success", paymentRes.Result.MStatus)
+	assert.Equal(t, "C-carrier-order-001", paymentRes.Result.CarrierOrderID)
+
+	code, _ = postPayment(t, handler, "/payment/carrier/capture", `{"orderId":"carrier-order-001"}`)
+	assert.Equal(t, http.StatusOK, code)
+
+	code, _ = postPayment(t, handler, "/payment/carrier/cancel", `{"orderId":"carrier-order-001"}`)
+	assert.Equal(t, http.StatusOK, code)
+
+	// the feature phone is redirected by the html
+	code, paymentRes = postPayment(t, handler, "/payment/carrier/authorize", fmt.Sprintf(authorizeJSON, "carrier-order-002", "2"))
+	assert.Equal(t, http.StatusOK, code, paymentRes.Err)
+	assert.Empty(t, paymentRes.Result.RedirectURL)
+	assert.Contains(t, paymentRes.Result.ResResponseContents, fake.URL+"/carrier/pay")
+
+	// cancelled by the customer
+	code, paymentRes = returnFromForeign(t, handler, "carrier", fake.URL+"/carrier/pay?orderId=carrier-order-002&cancel=true")
+	assert.NotEqual(t, http.StatusOK, code)
+	assert.Nil(t, paymentRes.Result)
+
+	// reject the tampered callback
+	code, paymentRes = postPayment(t, handler, "/payment/carrier/authorize", fmt.Sprintf(authorizeJSON, "carrier-order-003", "0"))
+	assert.Equal(t, http.StatusOK, code, paymentRes.Err)
+	values := fake.PayCarrier("carrier-order-003", true)
+	values.Set("carrierOrderId", "C-other")
+	code, _ = getForeignCallback(t, handler, "carrier", values)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	assert.Equal(t, []string{
+		"Authorize/carrier", "Capture/carrier", "Cancel/carrier", "Authorize/carrier", "Authorize/carrier",
+	}, fake.Requests())
+
+	// reject the unknown terminal
+	code, _ = postPayment(t, handler, "/payment/carrier/authorize", fmt.Sprintf(authorizeJSON, "carrier-order-004", "9"))
+	assert.Equal(t, http.StatusBadRequest, code)
+}
+
+// TestGRPCCarrier function
+func TestGRPCCarrier(t *testing.T) {
+	fake := veritranstest.NewServer("test-ccid", "test-password")
+	defer fake.Close()
+	handler, server := getFakeServers(fake)
+	ctx := context.Background()
+	serviceType := veritrans.PaymentServiceTypes[veritrans.Carrier]
+	callbackURL := fmt.Sprintf(foreignCallbackURL, "carrier")
+
+	reply, err := server.Authorize(ctx, &pb.PaymentRequest{
+		ServiceType: &serviceType,
+		ServiceParams: &pb.PaymentRequest_Carrier{Carrier: &pb.CarrierPaymentRequest{
+			OrderID:           "carrier-order-005",
+			Amount:            "500",
+			ServiceOptionType: veritrans.CarrierServiceOptions[veritrans.AU],
+			TerminalKind:      veritrans.CarrierTerminalKinds[veritrans.TerminalPC],
+			ItemType:          veritrans.CarrierItemTypes[veritrans.ItemService],
+			SuccessURL:        callbackURL,
+			CancelURL:         callbackURL,
+			ErrorURL:          callbackURL,
+		}},
+	})
+	assert.Nil(t, err)
+	code, paymentRes := returnFromForeign(t, handler, "carrier", reply.Result.GetRedirectURL())
+	assert.Equal(t, http.StatusOK, code, paymentRes.Err)
+
+	_, err = server.Capture(ctx, &pb.PaymentRequest{ServiceType: &serviceType, OrderID: "carrier-order-005", Amount: "500"})
+	assert.Nil(t, err)
+
+	_, err = server.Cancel(ctx, &pb.PaymentRequest{ServiceType: &serviceType})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
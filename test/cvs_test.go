@@ -0,0 +1,84 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/david1992121/veritrans-microservice/api/pb"
+	"github.com/david1992121/veritrans-microservice/internal/veritrans"
+	"github.com/david1992121/veritrans-microservice/internal/veritrans/veritranstest"
+	assert "github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestHTTPCVS function
+func TestHTTPCVS(t *testing.T) {
+	fake := veritranstest.NewServer("test-ccid", "test-password")
+	defer fake.Close()
+	handler, _ := getFakeServers(fake)
+
+	code, paymentRes := postPayment(t, handler, "/payment/cvs/authorize",
+		`{"orderId":"cvs-order-001","amount":"1000","serviceOptionType":"sej","name1":"Taro","telNo":"0312345678","payLimit":"20300101"}`)
+	assert.Equal(t, http.StatusOK, code, paymentRes.Err)
+	assert.Equal(t, "R-cvs-order-001", paymentRes.Result.ReceiptNo)
+	assert.NotEmpty(t, paymentRes.Result.HaraikomiURL)
+
+	code, paymentRes = postPayment(t, handler, "/payment/cvs/cancel", `{"orderId":"cvs-order-001"}`)
+	assert.Equal(t, http.StatusOK, code, paymentRes.Err)
+
+	// reject unknown convenience store chain
+	code, _ = postPayment(t, handler, "/payment/cvs/authorize",
+		`{"orderId":"cvs-order-002","amount":"1000","serviceOptionType":"lawson","name1":"Taro","telNo":"0312345678","payLimit":"20300101"}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	// reject cancel without order id
+	code, _ = postPayment(t, handler, "/payment/cvs/cancel", `{}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	assert.Equal(t, []string{"Authorize/cvs", "Cancel/cvs"}, fake.Requests())
+}
+
+// TestGRPCCVS function
+func TestGRPCCVS(t *testing.T) {
+	fake := veritranstest.NewServer("test-ccid", "test-password")
+	defer fake.Close()
+	_, server := getFakeServers(fake)
+	ctx := context.Background()
+	serviceType := veritrans.PaymentServiceTypes[veritrans.CVS]
+
+	reply, err := server.Authorize(ctx, &pb.PaymentRequest{
+		ServiceType: &serviceType,
+		ServiceParams: &pb.PaymentRequest_Cvs{Cvs: &pb.CVSPaymentRequest{
+			OrderID:           "cvs-order-003",
+			Amount:            "1000",
+			ServiceOptionType: "econ",
+			Name1:             "Taro",
+			TelNo:             "0312345678",
+			PayLimit:          "20300101",
+		}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "R-cvs-order-003", reply.Result.GetReceiptNo())
+
+	_, err = server.Cancel(ctx, &pb.PaymentRequest{ServiceType: &serviceType, OrderID: "cvs-order-003"})
+	assert.Nil(t, err)
+
+	// reject the pay limit in the past
+	_, err = server.Authorize(ctx, &pb.PaymentRequest{
+		ServiceType: &serviceType,
+		ServiceParams: &pb.PaymentRequest_Cvs{Cvs: &pb.CVSPaymentRequest{
+			OrderID:           "cvs-order-004",
+			Amount:            "1000",
+			ServiceOptionType: "sej",
+			Name1:             "Taro",
+			TelNo:             "0312345678",
+			PayLimit:          "20000101",
+		}},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = server.Cancel(ctx, &pb.PaymentRequest{ServiceType: &serviceType})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,74 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/david1992121/veritrans-microservice/api/pb"
+	"github.com/david1992121/veritrans-microservice/internal/veritrans"
+	"github.com/david1992121/veritrans-microservice/internal/veritrans/veritranstest"
+	assert "github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestHTTPEM function
+func TestHTTPEM(t *testing.T) {
+	fake := veritranstest.NewServer("test-ccid", "test-password")
+	defer fake.Close()
+	handler, _ := getFakeServers(fake)
+
+	// the payment link is sent by mail
+	code, paymentRes := postPayment(t, handler, "/payment/em/authorize",
+		`{"orderId":"em-order-001","amount":"1000","serviceOptionType":"suica-mobile-mail","mailAddr":"customer@example.com"}`)
+	assert.Equal(t, http.StatusOK, code, paymentRes.Err)
+	assert.NotEmpty(t, paymentRes.Result.MailURL)
+	assert.Empty(t, paymentRes.Result.AppURL)
+
+	code, paymentRes = postPayment(t, handler, "/payment/em/refund", `{"orderId":"em-order-001","amount":"300"}`)
+	assert.Equal(t, http.StatusOK, code, paymentRes.Err)
+	assert.Equal(t, "300", paymentRes.Result.ReqAmount)
+
+	// the app is opened by the link
+	code, paymentRes = postPayment(t, handler, "/payment/em/authorize",
+		`{"orderId":"em-order-002","amount":"1000","serviceOptionType":"suica-mobile-app"}`)
+	assert.Equal(t, http.StatusOK, code, paymentRes.Err)
+	assert.NotEmpty(t, paymentRes.Result.AppURL)
+
+	code, _ = postPayment(t, handler, "/payment/em/cancel", `{"orderId":"em-order-002"}`)
+	assert.Equal(t, http.StatusOK, code)
+
+	assert.Equal(t, []string{"Authorize/em", "Refund/em", "Authorize/em", "Cancel/em"}, fake.Requests())
+
+	// the mail address is required for the mail link
+	code, _ = postPayment(t, handler, "/payment/em/authorize", `{"orderId":"em-order-003","amount":"1000","serviceOptionType":"suica-pc-mail"}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+}
+
+// TestGRPCEM function
+func TestGRPCEM(t *testing.T) {
+	fake := veritranstest.NewServer("test-ccid", "test-password")
+	defer fake.Close()
+	_, server := getFakeServers(fake)
+	ctx := context.Background()
+	serviceType := veritrans.PaymentServiceTypes[veritrans.EM]
+
+	reply, err := server.Authorize(ctx, &pb.PaymentRequest{
+		ServiceType: &serviceType,
+		ServiceParams: &pb.PaymentRequest_Em{Em: &pb.EMPaymentRequest{
+			OrderID:           "em-order-004",
+			Amount:            "1000",
+			ServiceOptionType: veritrans.EMServiceOptions[veritrans.SuicaPCApp],
+		}},
+	})
+	assert.Nil(t, err)
+	assert.NotEmpty(t, reply.Result.GetAppURL())
+	assert.NotEmpty(t, reply.Result.GetReceiptNo())
+
+	_, err = server.Refund(ctx, &pb.PaymentRequest{ServiceType: &serviceType, OrderID: "em-order-004"})
+	assert.Nil(t, err)
+
+	_, err = server.Cancel(ctx, &pb.PaymentRequest{ServiceType: &serviceType})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
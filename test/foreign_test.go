@@ -0,0 +1,132 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/david1992121/veritrans-microservice/api/pb"
+	"github.com/david1992121/veritrans-microservice/internal/veritrans"
+	"github.com/david1992121/veritrans-microservice/internal/veritrans/veritranstest"
+	assert "github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const foreignCallbackURL = "https://merchant.example.com/payment/%s/callback"
+
+// TestHTTPAlipay function
+func TestHTTPAlipay(t *testing.T) {
+	fake := veritranstest.NewServer("test-ccid", "test-password")
+	defer fake.Close()
+	handler, _ := getFakeServers(fake)
+	authorizeJSON := `{"orderId":"%s","amount":"%s","currency":"%s","commodityName":"souvenir","returnUrl":"` +
+		fmt.Sprintf(foreignCallbackURL, "alipay") + `"}`
+
+	// paid in chinese yuan and refunded partially
+	code, paymentRes := postPayment(t, handler, "/payment/alipay/authorize", fmt.Sprintf(authorizeJSON, "alipay-order-001", "52.30", "CNY"))
+	assert.Equal(t, http.StatusOK, code, paymentRes.Err)
+	assert.NotEmpty(t, paymentRes.Result.RedirectURL)
+
+	code, paymentRes = returnFromForeign(t, handler, "alipay", paymentRes.Result.RedirectURL)
+	assert.Equal(t, http.StatusOK, code, paymentRes.Err)
+	assert.Equal(t, "success", paymentRes.Result.MStatus)
+	assert.Equal(t, "alipay-order-001", paymentRes.Result.OrderID)
+	assert.Equal(t, "52.30", paymentRes.Result.ReqAmount)
+
+	code, paymentRes = postPayment(t, handler, "/payment/alipay/refund", `{"orderId":"alipay-order-001","amount":"10.50","currency":"CNY"}`)
+	assert.Equal(t, http.StatusOK, code, paymentRes.Err)
+	assert.Equal(t, "10.50", paymentRes.Result.ReqAmount)
+
+	// reject the tampered callback
+	code, paymentRes = postPayment(t, handler, "/payment/alipay/authorize", fmt.Sprintf(authorizeJSON, "alipay-order-002", "1000", "JPY"))
+	assert.Equal(t, http.StatusOK, code, paymentRes.Err)
+
+	values := fake.Pay("alipay-order-002", veritrans.Alipay, false)
+	values.Set("mstatus", "success")
+	code, _ = getForeignCallback(t, handler, "alipay", values)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	// the payment cancelled by the customer is not authorized
+	code, paymentRes = getForeignCallback(t, handler, "alipay", fake.Pay("alipay-order-002", veritrans.Alipay, false))
+	assert.NotEqual(t, http.StatusOK, code)
+	assert.Nil(t, paymentRes.Result)
+
+	// the callback is accepted only once
+	code, _ = getForeignCallback(t, handler, "alipay", fake.Pay("alipay-order-002", veritrans.Alipay, true))
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	assert.Equal(t, []string{"Authorize/alipay", "Refund/alipay", "Authorize/alipay"}, fake.Requests())
+
+	// reject the unsupported currency
+	code, _ = postPayment(t, handler, "/payment/alipay/authorize", fmt.Sprintf(authorizeJSON, "alipay-order-003", "10", "USD"))
+	assert.Equal(t, http.StatusBadRequest, code)
+}
+
+// TestHTTPUPop function
+func TestHTTPUPop(t *testing.T) {
+	fake := veritranstest.NewServer("test-ccid", "test-password")
+	defer fake.Close()
+	handler, _ := getFakeServers(fake)
+	authorizeJSON := `{"orderId":"upop-order-001","amount":"1000","commodityName":"souvenir","returnUrl":"` +
+		fmt.Sprintf(foreignCallbackURL, "upop") + `"}`
+
+	code, paymentRes := postPayment(t, handler, "/payment/upop/authorize", authorizeJSON)
+	assert.Equal(t, http.StatusOK, code, paymentRes.Err)
+
+	// the alipay callback does not complete the unionpay payment
+	code, _ = getForeignCallback(t, handler, "alipay", fake.Pay("upop-order-001", veritrans.UPop, true))
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	code, paymentRes = returnFromForeign(t, handler, "upop", paymentRes.Result.RedirectURL)
+	assert.Equal(t, http.StatusOK, code, paymentRes.Err)
+	assert.Equal(t, veritrans.PaymentServiceTypes[veritrans.UPop], paymentRes.Result.ServiceType)
+
+	code, _ = postPayment(t, handler, "/payment/upop/refund", `{"orderId":"upop-order-001"}`)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, []string{"Authorize/upop", "Refund/upop"}, fake.Requests())
+}
+
+// TestGRPCForeign function
+func TestGRPCForeign(t *testing.T) {
+	fake := veritranstest.NewServer("test-ccid", "test-password")
+	defer fake.Close()
+	handler, server := getFakeServers(fake)
+	ctx := context.Background()
+	serviceType := veritrans.PaymentServiceTypes[veritrans.Alipay]
+
+	currency := "CNY"
+	reply, err := server.Authorize(ctx, &pb.PaymentRequest{
+		ServiceType: &serviceType,
+		ServiceParams: &pb.PaymentRequest_Foreign{Foreign: &pb.ForeignPaymentRequest{
+			OrderID:       "alipay-order-004",
+			Amount:        "52.30",
+			Currency:      &currency,
+			CommodityName: "souvenir",
+			ReturnURL:     fmt.Sprintf(foreignCallbackURL, "alipay"),
+		}},
+	})
+	assert.Nil(t, err)
+	code, paymentRes := returnFromForeign(t, handler, "alipay", reply.Result.GetRedirectURL())
+	assert.Equal(t, http.StatusOK, code, paymentRes.Err)
+
+	_, err = server.Refund(ctx, &pb.PaymentRequest{ServiceType: &serviceType, OrderID: "alipay-order-004", Amount: "10.50", Currency: &currency})
+	assert.Nil(t, err)
+
+	serviceType = veritrans.PaymentServiceTypes[veritrans.UPop]
+	_, err = server.Authorize(ctx, &pb.PaymentRequest{
+		ServiceType: &serviceType,
+		ServiceParams: &pb.PaymentRequest_Foreign{Foreign: &pb.ForeignPaymentRequest{
+			OrderID:       "upop-order-002",
+			Amount:        "1000",
+			Currency:      &currency,
+			CommodityName: "souvenir",
+			ReturnURL:     fmt.Sprintf(foreignCallbackURL, "upop"),
+		}},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = server.Refund(ctx, &pb.PaymentRequest{ServiceType: &serviceType})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
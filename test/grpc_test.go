@@ -11,6 +11,8 @@ import (
 	"github.com/david1992121/veritrans-microservice/pkg/transport"
 	assert "github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
 )
 
@@ -71,15 +73,76 @@ func TestGRPCAccount(t *testing.T) {
 	resp, err := client.CreateAccount(ctx, &pb.AccountRequest{
 		AccountID: testAccountID,
 	})
-	assert.Nil(t, err)
 
-	if resp.Account != nil {
+	if err == nil {
 		assert.Equal(t, testAccountID, resp.Account.AccountID)
 	} else {
-		assert.Equal(t, "入会中の会員です。", resp.Err)
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
 	}
 }
 
+// TestGRPCAccountLifecycle function
+func TestGRPCAccountLifecycle(t *testing.T) {
+	ctx, client, err := getClient()
+	assert.Nil(t, err)
+
+	testAccountID := "test-grpc-account-02"
+	_, err = client.CreateAccount(ctx, &pb.AccountRequest{
+		AccountID: testAccountID,
+	})
+	if err != nil {
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	}
+
+	// get account
+	resp, err := client.GetAccount(ctx, &pb.AccountRequest{
+		AccountID: testAccountID,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, testAccountID, resp.Account.AccountID)
+
+	// reject invalid delete date
+	invalidDate := "2022-01-01"
+	_, err = client.DeleteAccount(ctx, &pb.AccountRequest{
+		AccountID: testAccountID,
+		AccountBasicParam: &pb.AccountRequest_AccountBasicParam{
+			DeleteDate: &invalidDate,
+		},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// delete account
+	resp, err = client.DeleteAccount(ctx, &pb.AccountRequest{
+		AccountID: testAccountID,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, testAccountID, resp.Account.AccountID)
+
+	// restore account
+	resp, err = client.RestoreAccount(ctx, &pb.AccountRequest{
+		AccountID: testAccountID,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, testAccountID, resp.Account.AccountID)
+}
+
+// TestGRPCRecurringCharge function
+func TestGRPCRecurringCharge(t *testing.T) {
+	ctx, client, err := getClient()
+	assert.Nil(t, err)
+
+	testAccountID := "test-grpc-account-01"
+	startDate, endDate, amount := "20300201", "20300101", "1000"
+	_, err = client.CreateRecurringCharge(ctx, &pb.RecurringChargeRequest{
+		AccountID: testAccountID,
+		GroupID:   "group",
+		Amount:    &amount,
+		StartDate: &startDate,
+		EndDate:   &endDate,
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
 // TestGRPCCard function
 func TestGRPCCard(t *testing.T) {
 	ctx, client, err := getClient()
@@ -152,3 +215,20 @@ func TestGRPCCard(t *testing.T) {
 		assert.Equal(t, "", resp.Err)
 	}
 }
+
+// TestGRPCSearch function
+func TestGRPCSearch(t *testing.T) {
+	ctx, client, err := getClient()
+	assert.Nil(t, err)
+
+	testOrderID := "test-grpc-order-not-found"
+	newerFlag := "true"
+	resp, err := client.SearchOrders(ctx, &pb.SearchRequest{
+		OrderID:      &testOrderID,
+		ServiceTypes: []string{"card"},
+		NewerFlag:    &newerFlag,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "", resp.Err)
+	assert.Equal(t, 0, len(resp.OrderInfo))
+}
@@ -0,0 +1,77 @@
+package test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/david1992121/veritrans-microservice/api/pb"
+	"github.com/david1992121/veritrans-microservice/internal/veritrans"
+	"github.com/david1992121/veritrans-microservice/internal/veritrans/veritranstest"
+	"github.com/david1992121/veritrans-microservice/pkg"
+	"github.com/david1992121/veritrans-microservice/pkg/endpoint"
+	"github.com/david1992121/veritrans-microservice/pkg/transport"
+	assert "github.com/stretchr/testify/require"
+)
+
+// Get the http handler and the grpc server using the fake veritrans server
+// All payment services are enabled unless the enabled service types are given
+func getFakeServers(fake *veritranstest.Server, enabledServiceTypes ...string) (http.Handler, pb.VeritransServer) {
+	serviceConfig := &pkg.ServiceConfig{
+		ConnectionConfig: veritrans.ConnectionConfig{
+			MerchantCCID:        fake.MerchantCCID,
+			MerchantPassword:    fake.MerchantPassword,
+			PaymentAPIURL:       fake.URL,
+			SearchAPIURL:        fake.URL,
+			TxnVersion:          "2.0.0",
+			DummyRequest:        "1",
+			EnabledServiceTypes: enabledServiceTypes,
+		},
+	}
+	eps := endpoint.NewEndpointSet(pkg.NewService(serviceConfig))
+	return transport.NewHTTPHandler(eps), transport.NewGRPCServer(eps)
+}
+
+// Post the json request to the handler and decode the payment response
+func postPayment(t *testing.T, handler http.Handler, route string, jsonStr string) (int, endpoint.PaymentResponse) {
+	req := httptest.NewRequest(http.MethodPost, route, bytes.NewBufferString(jsonStr))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	var paymentRes endpoint.PaymentResponse
+	err := json.Unmarshal(rec.Body.Bytes(), &paymentRes)
+	assert.Nil(t, err, rec.Body.String())
+	return rec.Code, paymentRes
+}
+
+// Get the return callback of the handler with the values
+func getForeignCallback(t *testing.T, handler http.Handler, service string, values url.Values) (int, endpoint.PaymentResponse) {
+	req := httptest.NewRequest(http.MethodGet, "/payment/"+service+"/callback?"+values.Encode(), nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	var paymentRes endpoint.PaymentResponse
+	err := json.Unmarshal(rec.Body.Bytes(), &paymentRes)
+	assert.Nil(t, err, rec.Body.String())
+	return rec.Code, paymentRes
+}
+
+// Open the payment page of alipay or unionpay and follow the redirect back to the callback of the handler
+func returnFromForeign(t *testing.T, handler http.Handler, service string, redirectURL string) (int, endpoint.PaymentResponse) {
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+	res, err := client.Get(redirectURL)
+	assert.Nil(t, err)
+	res.Body.Close()
+	assert.Equal(t, http.StatusFound, res.StatusCode)
+
+	returnURL, err := url.Parse(res.Header.Get("Location"))
+	assert.Nil(t, err)
+	assert.Equal(t, fmt.Sprintf(foreignCallbackURL, service), fmt.Sprintf("%s://%s%s", returnURL.Scheme, returnURL.Host, returnURL.Path))
+	return getForeignCallback(t, handler, service, returnURL.Query())
+}
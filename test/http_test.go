@@ -50,16 +50,116 @@ func TestHTTPAccount(t *testing.T) {
 	rec := httptest.NewRecorder()
 
 	httpHandler.ServeHTTP(rec, req)
-	assert.Equal(t, rec.Code, 200)
 
 	var accountRes endpoint.AccountResponse
 	err := json.Unmarshal([]byte(rec.Body.String()), &accountRes)
 	assert.Nil(t, err)
 
 	if accountRes.Account != nil {
+		assert.Equal(t, rec.Code, http.StatusOK)
 		assert.Equal(t, testAccountID, accountRes.Account.AccountID)
 	} else {
-		assert.Equal(t, "入会中の会員です。", accountRes.Err)
+		assert.Equal(t, rec.Code, http.StatusConflict)
+	}
+}
+
+// TestHTTPAccountLifecycle function
+func TestHTTPAccountLifecycle(t *testing.T) {
+	testAccountID := "test-account-002"
+	var accountRes endpoint.AccountResponse
+
+	// create account if not exists
+	{
+		jsonStr := []byte(fmt.Sprintf(`{"accountId":"%s"}`, testAccountID))
+		req := httptest.NewRequest(http.MethodPost, "/account/create", bytes.NewBuffer(jsonStr))
+		rec := httptest.NewRecorder()
+
+		httpHandler.ServeHTTP(rec, req)
+		assert.Contains(t, []int{http.StatusOK, http.StatusConflict}, rec.Code)
+	}
+
+	// get account
+	{
+		jsonStr := []byte(fmt.Sprintf(`{"accountId":"%s"}`, testAccountID))
+		req := httptest.NewRequest(http.MethodPost, "/account/get", bytes.NewBuffer(jsonStr))
+		rec := httptest.NewRecorder()
+
+		httpHandler.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		err := json.Unmarshal([]byte(rec.Body.String()), &accountRes)
+		assert.Nil(t, err)
+		assert.Equal(t, testAccountID, accountRes.Account.AccountID)
+	}
+
+	// reject invalid delete date
+	{
+		jsonStr := []byte(fmt.Sprintf(`{"accountId":"%s","accountBasicParam":{"deleteDate":"2022-01-01"}}`, testAccountID))
+		req := httptest.NewRequest(http.MethodPost, "/account/delete", bytes.NewBuffer(jsonStr))
+		rec := httptest.NewRecorder()
+
+		httpHandler.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	}
+
+	// delete account
+	{
+		jsonStr := []byte(fmt.Sprintf(`{"accountId":"%s"}`, testAccountID))
+		req := httptest.NewRequest(http.MethodPost, "/account/delete", bytes.NewBuffer(jsonStr))
+		rec := httptest.NewRecorder()
+
+		httpHandler.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	}
+
+	// restore account
+	{
+		jsonStr := []byte(fmt.Sprintf(`{"accountId":"%s"}`, testAccountID))
+		req := httptest.NewRequest(http.MethodPost, "/account/restore", bytes.NewBuffer(jsonStr))
+		rec := httptest.NewRecorder()
+
+		httpHandler.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		err := json.Unmarshal([]byte(rec.Body.String()), &accountRes)
+		assert.Nil(t, err)
+		assert.Equal(t, testAccountID, accountRes.Account.AccountID)
+	}
+}
+
+// TestHTTPRecurringCharge function
+func TestHTTPRecurringCharge(t *testing.T) {
+	testAccountID := "test-account-001"
+
+	// reject invalid dates
+	{
+		jsonStr := []byte(fmt.Sprintf(`{"accountId":"%s","recurringChargeParam":{"groupId":"group","amount":"1000","startDate":"20300201","endDate":"20300101"}}`,
+			testAccountID))
+		req := httptest.NewRequest(http.MethodPost, "/recurring/create", bytes.NewBuffer(jsonStr))
+		rec := httptest.NewRecorder()
+
+		httpHandler.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	}
+
+	groupID := os.Getenv("RECURRING_GROUP_ID")
+	if groupID == "" {
+		t.Skip("RECURRING_GROUP_ID is not set")
+	}
+
+	for _, route := range []string{"/recurring/create", "/recurring/get", "/recurring/delete"} {
+		jsonStr := []byte(fmt.Sprintf(`{"accountId":"%s","recurringChargeParam":{"groupId":"%s","amount":"1000"}}`,
+			testAccountID, groupID))
+		req := httptest.NewRequest(http.MethodPost, route, bytes.NewBuffer(jsonStr))
+		rec := httptest.NewRecorder()
+
+		httpHandler.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusOK, rec.Code, route)
+
+		var recurringRes endpoint.RecurringChargeResponse
+		err := json.Unmarshal([]byte(rec.Body.String()), &recurringRes)
+		assert.Nil(t, err)
+		assert.Equal(t, testAccountID, recurringRes.AccountID)
 	}
 }
 
@@ -173,13 +273,14 @@ func TestHTTPPayment(t *testing.T) {
 		rec := httptest.NewRecorder()
 
 		httpHandler.ServeHTTP(rec, req)
-		assert.Equal(t, rec.Code, 200)
 
 		err := json.Unmarshal([]byte(rec.Body.String()), &paymentRes)
 		assert.Nil(t, err)
 		if paymentRes.Err != "" {
+			assert.Equal(t, rec.Code, http.StatusConflict)
 			continue
 		}
+		assert.Equal(t, rec.Code, 200)
 		break
 	}
 
@@ -193,13 +294,14 @@ func TestHTTPPayment(t *testing.T) {
 		rec := httptest.NewRecorder()
 
 		httpHandler.ServeHTTP(rec, req)
-		assert.Equal(t, rec.Code, 200)
 
 		err := json.Unmarshal([]byte(rec.Body.String()), &paymentRes)
 		assert.Nil(t, err)
 		if paymentRes.Err != "" {
+			assert.Equal(t, rec.Code, http.StatusConflict)
 			continue
 		}
+		assert.Equal(t, rec.Code, 200)
 		break
 	}
 
@@ -216,6 +318,29 @@ func TestHTTPPayment(t *testing.T) {
 		err := json.Unmarshal([]byte(rec.Body.String()), &paymentRes)
 		assert.Nil(t, err)
 		assert.Equal(t, "", paymentRes.Err)
+		assert.NotNil(t, paymentRes.Result)
+		assert.Equal(t, "success", paymentRes.Result.MStatus)
+		assert.Equal(t, testOrderID, paymentRes.Result.OrderID)
+	}
+
+	// search
+	{
+		testOrderID := fmt.Sprintf("test-account-order-%d", orderNumber)
+		jsonStr := []byte(fmt.Sprintf(`{"orderId":"%s", "serviceTypes": ["card"], "newerFlag": "true"}`, testOrderID))
+		req := httptest.NewRequest(http.MethodPost, "/payment/search", bytes.NewBuffer(jsonStr))
+		rec := httptest.NewRecorder()
+
+		httpHandler.ServeHTTP(rec, req)
+		assert.Equal(t, rec.Code, 200)
+
+		var searchRes endpoint.SearchResponse
+		err := json.Unmarshal([]byte(rec.Body.String()), &searchRes)
+		assert.Nil(t, err)
+		assert.Equal(t, "", searchRes.Err)
+		assert.Equal(t, 1, len(searchRes.Orders))
+		assert.Equal(t, testOrderID, searchRes.Orders[0].OrderID)
+		assert.Equal(t, testAccountID, searchRes.Orders[0].AccountID)
+		assert.Equal(t, "Capture", searchRes.Orders[0].LastSuccessTxnType)
 	}
 
 	// remove card
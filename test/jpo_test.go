@@ -0,0 +1,84 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/david1992121/veritrans-microservice/api/pb"
+	"github.com/david1992121/veritrans-microservice/internal/veritrans/veritranstest"
+	"github.com/david1992121/veritrans-microservice/pkg/endpoint"
+	assert "github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestHTTPPaymentOption function
+func TestHTTPPaymentOption(t *testing.T) {
+	fake := veritranstest.NewServer("test-ccid", "test-password")
+	defer fake.Close()
+	handler, _ := getFakeServers(fake)
+
+	code, paymentRes := postPayment(t, handler, "/authorize",
+		`{"orderId":"jpo-order-001","amount":"30000","paymentOption":{"type":"installment","installmentCount":3},"payNowIdParam":{"token":"test-token"}}`)
+	assert.Equal(t, http.StatusOK, code, paymentRes.Err)
+
+	// the option is sent to veritrans as the jpo
+	req := httptest.NewRequest(http.MethodPost, "/payment/search", bytes.NewBufferString(`{"orderId":"jpo-order-001","serviceTypes":["card"]}`))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var searchRes endpoint.SearchResponse
+	err := json.Unmarshal(rec.Body.Bytes(), &searchRes)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(searchRes.Orders))
+	assert.Equal(t, "61C03", searchRes.Orders[0].TransactionInfos.TransactionInfo[0].ProperInfo.ReqJPOInformation)
+
+	code, paymentRes = postPayment(t, handler, "/authorize",
+		`{"orderId":"jpo-order-002","amount":"30000","paymentOption":{"type":"installment","installmentCount":1},"payNowIdParam":{"token":"test-token"}}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Contains(t, paymentRes.Err, "paymentOption.installmentCount")
+
+	code, paymentRes = postPayment(t, handler, "/authorize",
+		`{"orderId":"jpo-order-003","amount":"30000","jpo":"10","paymentOption":{"type":"revolving"},"payNowIdParam":{"token":"test-token"}}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Contains(t, paymentRes.Err, "conflicts with paymentOption")
+
+	assert.Equal(t, []string{"Authorize/card", "Search/search"}, fake.Requests())
+}
+
+// TestGRPCPaymentOption function
+func TestGRPCPaymentOption(t *testing.T) {
+	fake := veritranstest.NewServer("test-ccid", "test-password")
+	defer fake.Close()
+	handler, server := getFakeServers(fake)
+	ctx := context.Background()
+
+	code, paymentRes := postPayment(t, handler, "/authorize", `{"orderId":"jpo-order-004","amount":"1000","payNowIdParam":{"token":"test-token"}}`)
+	assert.Equal(t, http.StatusOK, code, paymentRes.Err)
+
+	_, err := server.ReAuthorize(ctx, &pb.ReAuthorizeRequest{
+		OrderID:         "jpo-order-005",
+		OriginalOrderID: "jpo-order-004",
+		Amount:          "1000",
+		PaymentOption:   &pb.PaymentOption{Type: "bonus"},
+	})
+	assert.Nil(t, err)
+
+	_, err = server.ReAuthorize(ctx, &pb.ReAuthorizeRequest{
+		OrderID:         "jpo-order-006",
+		OriginalOrderID: "jpo-order-004",
+		Amount:          "1000",
+		PaymentOption:   &pb.PaymentOption{Type: "monthly"},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	reply, err := server.SearchOrders(ctx, &pb.SearchRequest{ServiceTypes: []string{"card"}})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(reply.OrderInfo))
+	assert.Equal(t, "21", reply.OrderInfo[1].TransactionInfo[0].ReqJpoInformation)
+}
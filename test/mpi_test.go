@@ -0,0 +1,157 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/david1992121/veritrans-microservice/api/pb"
+	"github.com/david1992121/veritrans-microservice/internal/veritrans"
+	"github.com/david1992121/veritrans-microservice/internal/veritrans/veritranstest"
+	"github.com/david1992121/veritrans-microservice/pkg/endpoint"
+	assert "github.com/stretchr/testify/require"
+)
+
+const mpiCallbackURI = "https://merchant.example.com/payment/mpi/callback"
+
+var formInputPattern = regexp.MustCompile(`name="([^"]+)" value="([^"]*)"`)
+
+// Post the callback values to the handler
+func postMPICallback(handler http.Handler, values url.Values) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/payment/mpi/callback", strings.NewReader(values.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+// Authorize the 3-D secure payment over http and return the html redirecting to the ACS
+func authorizeMPI(t *testing.T, handler http.Handler, orderID string, serviceOption string) string {
+	jsonStr := []byte(fmt.Sprintf(`{"orderId":"%s","amount":"1000","serviceOptionType":"%s","payNowIdParam":{"token":"test-token"},"redirectionUri":"%s"}`,
+		orderID, serviceOption, mpiCallbackURI))
+	req := httptest.NewRequest(http.MethodPost, "/payment/mpi/authorize", bytes.NewBuffer(jsonStr))
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var paymentRes endpoint.PaymentResponse
+	err := json.Unmarshal(rec.Body.Bytes(), &paymentRes)
+	assert.Nil(t, err)
+	assert.NotNil(t, paymentRes.Result)
+	return paymentRes.Result.ResResponseContents
+}
+
+// TestHTTPMPI function
+func TestHTTPMPI(t *testing.T) {
+	acs := veritranstest.NewServer("test-ccid", "test-password")
+	defer acs.Close()
+	handler, _ := getFakeServers(acs)
+
+	// the customer is redirected to the ACS
+	contents := authorizeMPI(t, handler, "mpi-order-001", veritrans.MPIServiceOptions[veritrans.MPIComplete])
+	assert.Contains(t, contents, acs.URL+"/acs")
+
+	// the ACS authenticates the customer and posts the result back
+	res, err := http.PostForm(acs.URL+"/acs", url.Values{"orderId": {"mpi-order-001"}})
+	assert.Nil(t, err)
+	defer res.Body.Close()
+	var body bytes.Buffer
+	_, err = body.ReadFrom(res.Body)
+	assert.Nil(t, err)
+	assert.Contains(t, body.String(), mpiCallbackURI)
+
+	values := url.Values{}
+	for _, match := range formInputPattern.FindAllStringSubmatch(body.String(), -1) {
+		values.Set(html.UnescapeString(match[1]), html.UnescapeString(match[2]))
+	}
+	rec := postMPICallback(handler, values)
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var paymentRes endpoint.PaymentResponse
+	err = json.Unmarshal(rec.Body.Bytes(), &paymentRes)
+	assert.Nil(t, err)
+	assert.Equal(t, "mpi-order-001", paymentRes.Result.OrderID)
+	assert.Equal(t, "success", paymentRes.Result.MStatus)
+	assert.Equal(t, []string{"Authorize/mpi"}, acs.Requests())
+
+	// the callback is accepted only once
+	rec = postMPICallback(handler, values)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+// TestHTTPMPICallback function
+func TestHTTPMPICallback(t *testing.T) {
+	acs := veritranstest.NewServer("test-ccid", "test-password")
+	defer acs.Close()
+	handler, _ := getFakeServers(acs)
+
+	// reject the tampered callback
+	authorizeMPI(t, handler, "mpi-order-002", veritrans.MPIServiceOptions[veritrans.MPIComplete])
+	values := acs.Authenticate("mpi-order-002", false)
+	values.Set("mstatus", "success")
+	rec := postMPICallback(handler, values)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	// the failed authentication is not authorized
+	rec = postMPICallback(handler, acs.Authenticate("mpi-order-002", false))
+	assert.Equal(t, http.StatusPaymentRequired, rec.Code)
+
+	// reject the unknown order
+	rec = postMPICallback(handler, acs.Authenticate("mpi-order-unknown", true))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	// the authentication only option is authorized by the card api
+	authorizeMPI(t, handler, "mpi-order-003", veritrans.MPIServiceOptions[veritrans.MPINone])
+	rec = postMPICallback(handler, acs.Authenticate("mpi-order-003", true))
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var paymentRes endpoint.PaymentResponse
+	err := json.Unmarshal(rec.Body.Bytes(), &paymentRes)
+	assert.Nil(t, err)
+	assert.Equal(t, veritrans.PaymentServiceTypes[veritrans.PayCard], paymentRes.Result.ServiceType)
+	assert.Equal(t, []string{"Authorize/mpi", "Authorize/mpi", "Authorize/card"}, acs.Requests())
+
+	// the callback can be retried until the card is authorized
+	authorizeMPI(t, handler, "mpi-order-005", veritrans.MPIServiceOptions[veritrans.MPINone])
+	acs.FailNext("Authorize/card")
+	rec = postMPICallback(handler, acs.Authenticate("mpi-order-005", true))
+	assert.Equal(t, http.StatusBadGateway, rec.Code, rec.Body.String())
+	rec = postMPICallback(handler, acs.Authenticate("mpi-order-005", true))
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	rec = postMPICallback(handler, acs.Authenticate("mpi-order-005", true))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+// TestGRPCMPI function
+func TestGRPCMPI(t *testing.T) {
+	acs := veritranstest.NewServer("test-ccid", "test-password")
+	defer acs.Close()
+	handler, server := getFakeServers(acs)
+	ctx := context.Background()
+	serviceType := veritrans.PaymentServiceTypes[veritrans.MPI]
+
+	reply, err := server.Authorize(ctx, &pb.PaymentRequest{
+		ServiceType: &serviceType,
+		ServiceParams: &pb.PaymentRequest_Mpi{Mpi: &pb.MPIPaymentRequest{
+			OrderID:           "mpi-order-004",
+			Amount:            "1000",
+			ServiceOptionType: veritrans.MPIServiceOptions[veritrans.MPIMerchant],
+			PayNowIDParam:     &pb.PaymentRequest_PayNowIDParam{Token: "test-token"},
+			RedirectionURI:    mpiCallbackURI,
+		}},
+	})
+	assert.Nil(t, err)
+	assert.Contains(t, reply.Result.GetResResponseContents(), acs.URL+"/acs")
+
+	rec := postMPICallback(handler, acs.Authenticate("mpi-order-004", true))
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+}
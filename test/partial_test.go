@@ -0,0 +1,77 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/david1992121/veritrans-microservice/api/pb"
+	"github.com/david1992121/veritrans-microservice/internal/veritrans/veritranstest"
+	assert "github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestHTTPPartial function
+func TestHTTPPartial(t *testing.T) {
+	fake := veritranstest.NewServer("test-ccid", "test-password")
+	defer fake.Close()
+	handler, _ := getFakeServers(fake)
+
+	code, paymentRes := postPayment(t, handler, "/authorize", `{"orderId":"partial-order-001","amount":"1000","withCapture":"false","payNowIdParam":{"token":"test-token"}}`)
+	assert.Equal(t, http.StatusOK, code, paymentRes.Err)
+
+	code, paymentRes = postPayment(t, handler, "/payment/card/partial-capture", `{"orderId":"partial-order-001","amount":"600"}`)
+	assert.Equal(t, http.StatusOK, code, paymentRes.Err)
+	assert.Equal(t, "600", paymentRes.Result.RefundableAmount)
+
+	// only 400 is left to capture
+	code, paymentRes = postPayment(t, handler, "/payment/card/partial-capture", `{"orderId":"partial-order-001","amount":"500"}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Contains(t, paymentRes.Err, "exceeds the capturable amount 400")
+
+	code, paymentRes = postPayment(t, handler, "/payment/card/partial-cancel", `{"orderId":"partial-order-001","amount":"200"}`)
+	assert.Equal(t, http.StatusOK, code, paymentRes.Err)
+	assert.Equal(t, "400", paymentRes.Result.RefundableAmount)
+
+	code, paymentRes = postPayment(t, handler, "/payment/card/partial-cancel", `{"orderId":"partial-order-001","amount":"401"}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Contains(t, paymentRes.Err, "exceeds the refundable amount 400")
+
+	code, paymentRes = postPayment(t, handler, "/payment/card/partial-cancel", `{"orderId":"partial-order-002","amount":"100"}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Contains(t, paymentRes.Err, "order not found")
+
+	assert.Equal(t, []string{
+		"Authorize/card",
+		"Search/search", "Capture/card",
+		"Search/search",
+		"Search/search", "Cancel/card",
+		"Search/search",
+		"Search/search",
+	}, fake.Requests())
+}
+
+// TestGRPCPartial function
+func TestGRPCPartial(t *testing.T) {
+	fake := veritranstest.NewServer("test-ccid", "test-password")
+	defer fake.Close()
+	handler, server := getFakeServers(fake)
+	ctx := context.Background()
+
+	code, paymentRes := postPayment(t, handler, "/authorize", `{"orderId":"partial-order-003","amount":"1000","withCapture":"true","payNowIdParam":{"token":"test-token"}}`)
+	assert.Equal(t, http.StatusOK, code, paymentRes.Err)
+
+	// the authorization with the capture has nothing left to capture
+	_, err := server.PartialCapture(ctx, &pb.PaymentRequest{OrderID: "partial-order-003", Amount: "100"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	reply, err := server.PartialCancel(ctx, &pb.PaymentRequest{OrderID: "partial-order-003", Amount: "250"})
+	assert.Nil(t, err)
+	assert.Equal(t, "750", reply.Result.GetRefundableAmount())
+
+	serviceType := "cvs"
+	_, err = server.PartialCapture(ctx, &pb.PaymentRequest{ServiceType: &serviceType, OrderID: "partial-order-003", Amount: "100"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, err.Error(), "partial capture is not supported by cvs")
+}
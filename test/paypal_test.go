@@ -0,0 +1,158 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/david1992121/veritrans-microservice/api/pb"
+	"github.com/david1992121/veritrans-microservice/internal/veritrans"
+	"github.com/david1992121/veritrans-microservice/internal/veritrans/veritranstest"
+	"github.com/david1992121/veritrans-microservice/pkg/endpoint"
+	assert "github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const paypalCallbackURL = "https://merchant.example.com/payment/paypal/callback"
+
+// Open the paypal login url and return the query of the redirect back to the callback
+func followPaypalLogin(t *testing.T, loginURL string, cancel bool) string {
+	if cancel {
+		loginURL += "&cancel=true"
+	}
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+	res, err := client.Get(loginURL)
+	assert.Nil(t, err)
+	res.Body.Close()
+	assert.Equal(t, http.StatusFound, res.StatusCode)
+
+	redirectURL, err := url.Parse(res.Header.Get("Location"))
+	assert.Nil(t, err)
+	assert.Equal(t, paypalCallbackURL, fmt.Sprintf("%s://%s%s", redirectURL.Scheme, redirectURL.Host, redirectURL.Path))
+	return redirectURL.RawQuery
+}
+
+// Get the paypal callback of the handler with the query
+func getPaypalCallback(t *testing.T, handler http.Handler, query string) (int, endpoint.PaymentResponse) {
+	req := httptest.NewRequest(http.MethodGet, "/payment/paypal/callback?"+query, nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	var paymentRes endpoint.PaymentResponse
+	err := json.Unmarshal(rec.Body.Bytes(), &paymentRes)
+	assert.Nil(t, err, rec.Body.String())
+	return rec.Code, paymentRes
+}
+
+// Open the paypal login url and follow the redirect back to the callback of the handler
+func returnFromPaypal(t *testing.T, handler http.Handler, loginURL string, cancel bool) (int, endpoint.PaymentResponse) {
+	return getPaypalCallback(t, handler, followPaypalLogin(t, loginURL, cancel))
+}
+
+// TestHTTPPaypal function
+func TestHTTPPaypal(t *testing.T) {
+	fake := veritranstest.NewServer("test-ccid", "test-password")
+	defer fake.Close()
+	handler, _ := getFakeServers(fake)
+	authorizeJSON := `{"orderId":"%s","amount":"1000","returnUrl":"` + paypalCallbackURL + `","cancelUrl":"` + paypalCallbackURL + `"}`
+
+	// approved by the customer
+	code, paymentRes := postPayment(t, handler, "/payment/paypal/authorize", fmt.Sprintf(authorizeJSON, "paypal-order-001"))
+	assert.Equal(t, http.StatusOK, code, paymentRes.Err)
+	assert.NotEmpty(t, paymentRes.Result.LoginURL)
+
+	code, paymentRes = returnFromPaypal(t, handler, paymentRes.Result.LoginURL, false)
+	assert.Equal(t, http.StatusOK, code, paymentRes.Err)
+	assert.Equal(t, "success", paymentRes.Result.MStatus)
+	assert.Equal(t, "paypal-order-001", paymentRes.Result.OrderID)
+
+	code, paymentRes = postPayment(t, handler, "/payment/paypal/capture", `{"orderId":"paypal-order-001"}`)
+	assert.Equal(t, http.StatusOK, code, paymentRes.Err)
+
+	code, _ = postPayment(t, handler, "/payment/paypal/cancel", `{"orderId":"paypal-order-001"}`)
+	assert.Equal(t, http.StatusOK, code)
+
+	// cancelled by the customer
+	code, paymentRes = postPayment(t, handler, "/payment/paypal/authorize", fmt.Sprintf(authorizeJSON, "paypal-order-002"))
+	assert.Equal(t, http.StatusOK, code, paymentRes.Err)
+
+	loginURL := paymentRes.Result.LoginURL
+
+	// reject the callback which is not signed
+	code, _ = getPaypalCallback(t, handler, "orderId=paypal-order-002&token=EC-paypal-order-002")
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	cancelQuery := followPaypalLogin(t, loginURL, true)
+	code, paymentRes = getPaypalCallback(t, handler, cancelQuery)
+	assert.Equal(t, http.StatusOK, code, paymentRes.Err)
+	assert.Equal(t, veritrans.PaypalCancelStatus, paymentRes.Result.MStatus)
+
+	// the callback is accepted only once
+	code, _ = getPaypalCallback(t, handler, cancelQuery)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	// the return callback can be retried until the payment is authorized
+	code, paymentRes = postPayment(t, handler, "/payment/paypal/authorize", fmt.Sprintf(authorizeJSON, "paypal-order-003"))
+	assert.Equal(t, http.StatusOK, code, paymentRes.Err)
+	returnQuery := followPaypalLogin(t, paymentRes.Result.LoginURL, false)
+	returnValues, err := url.ParseQuery(returnQuery)
+	assert.Nil(t, err)
+
+	// the signed return url without the approval of the customer
+	returnValues.Del("PayerID")
+	code, _ = getPaypalCallback(t, handler, returnValues.Encode())
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	fake.FailNext("Authorize/paypal")
+	code, _ = getPaypalCallback(t, handler, returnQuery)
+	assert.Equal(t, http.StatusBadGateway, code)
+	code, paymentRes = getPaypalCallback(t, handler, returnQuery)
+	assert.Equal(t, http.StatusOK, code, paymentRes.Err)
+	assert.Equal(t, "success", paymentRes.Result.MStatus)
+
+	assert.Equal(t, []string{
+		"Authorize/paypal", "Authorize/paypal", "Capture/paypal", "Cancel/paypal", "Authorize/paypal",
+		"Authorize/paypal", "Authorize/paypal", "Authorize/paypal",
+	}, fake.Requests())
+
+	// reject the invalid return url
+	code, _ = postPayment(t, handler, "/payment/paypal/authorize", `{"orderId":"paypal-order-005","amount":"1000","returnUrl":"/return","cancelUrl":"/cancel"}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+}
+
+// TestGRPCPaypal function
+func TestGRPCPaypal(t *testing.T) {
+	fake := veritranstest.NewServer("test-ccid", "test-password")
+	defer fake.Close()
+	handler, server := getFakeServers(fake)
+	ctx := context.Background()
+	serviceType := veritrans.PaymentServiceTypes[veritrans.Paypal]
+
+	reply, err := server.Authorize(ctx, &pb.PaymentRequest{
+		ServiceType: &serviceType,
+		ServiceParams: &pb.PaymentRequest_Paypal{Paypal: &pb.PaypalPaymentRequest{
+			OrderID:   "paypal-order-004",
+			Amount:    "1000",
+			ReturnURL: paypalCallbackURL,
+			CancelURL: paypalCallbackURL,
+		}},
+	})
+	assert.Nil(t, err)
+	assert.NotEmpty(t, reply.Result.GetLoginURL())
+
+	code, paymentRes := returnFromPaypal(t, handler, reply.Result.GetLoginURL(), false)
+	assert.Equal(t, http.StatusOK, code, paymentRes.Err)
+
+	_, err = server.Capture(ctx, &pb.PaymentRequest{ServiceType: &serviceType, OrderID: "paypal-order-004"})
+	assert.Nil(t, err)
+
+	_, err = server.Cancel(ctx, &pb.PaymentRequest{ServiceType: &serviceType})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
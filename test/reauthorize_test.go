@@ -0,0 +1,71 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/david1992121/veritrans-microservice/api/pb"
+	"github.com/david1992121/veritrans-microservice/internal/veritrans/veritranstest"
+	assert "github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestHTTPReAuthorize function
+func TestHTTPReAuthorize(t *testing.T) {
+	fake := veritranstest.NewServer("test-ccid", "test-password")
+	defer fake.Close()
+	handler, _ := getFakeServers(fake)
+
+	code, paymentRes := postPayment(t, handler, "/authorize", `{"orderId":"reauth-order-001","amount":"1000","withCapture":"false","payNowIdParam":{"token":"test-token"}}`)
+	assert.Equal(t, http.StatusOK, code, paymentRes.Err)
+
+	code, paymentRes = postPayment(t, handler, "/payment/card/reauthorize", `{"orderId":"reauth-order-002","originalOrderId":"reauth-order-001","amount":"1500","cancelOriginal":true}`)
+	assert.Equal(t, http.StatusOK, code, paymentRes.Err)
+	assert.Equal(t, "reauth-order-002", paymentRes.Result.OrderID)
+	assert.Equal(t, "1500", paymentRes.Result.ReqAmount)
+
+	// the new authorization can be captured with its own amount
+	code, paymentRes = postPayment(t, handler, "/payment/card/partial-capture", `{"orderId":"reauth-order-002","amount":"1500"}`)
+	assert.Equal(t, http.StatusOK, code, paymentRes.Err)
+
+	// the original order is not found
+	code, _ = postPayment(t, handler, "/payment/card/reauthorize", `{"orderId":"reauth-order-004","originalOrderId":"reauth-order-003","amount":"1500"}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	code, _ = postPayment(t, handler, "/payment/card/reauthorize", `{"orderId":"reauth-order-001","originalOrderId":"reauth-order-001","amount":"1500"}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	assert.Equal(t, []string{
+		"Authorize/card",
+		"ReAuthorize/card", "Cancel/card",
+		"Search/search", "Capture/card",
+		"ReAuthorize/card",
+	}, fake.Requests())
+}
+
+// TestGRPCReAuthorize function
+func TestGRPCReAuthorize(t *testing.T) {
+	fake := veritranstest.NewServer("test-ccid", "test-password")
+	defer fake.Close()
+	handler, server := getFakeServers(fake)
+	ctx := context.Background()
+
+	code, paymentRes := postPayment(t, handler, "/authorize", `{"orderId":"reauth-order-005","amount":"1000","payNowIdParam":{"token":"test-token"}}`)
+	assert.Equal(t, http.StatusOK, code, paymentRes.Err)
+
+	reply, err := server.ReAuthorize(ctx, &pb.ReAuthorizeRequest{
+		OrderID:         "reauth-order-006",
+		OriginalOrderID: "reauth-order-005",
+		Amount:          "800",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "800", reply.Result.Amount)
+
+	_, err = server.ReAuthorize(ctx, &pb.ReAuthorizeRequest{OrderID: "reauth-order-007", Amount: "800"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// the original order is kept without cancelOriginal
+	assert.Equal(t, []string{"Authorize/card", "ReAuthorize/card"}, fake.Requests())
+}
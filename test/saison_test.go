@@ -0,0 +1,132 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/david1992121/veritrans-microservice/api/pb"
+	"github.com/david1992121/veritrans-microservice/internal/veritrans"
+	"github.com/david1992121/veritrans-microservice/internal/veritrans/veritranstest"
+	"github.com/david1992121/veritrans-microservice/pkg/endpoint"
+	assert "github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestHTTPSaison function
+func TestHTTPSaison(t *testing.T) {
+	fake := veritranstest.NewServer("test-ccid", "test-password")
+	defer fake.Close()
+	handler, _ := getFakeServers(fake)
+
+	code, paymentRes := postPayment(t, handler, "/payment/saison/authorize",
+		`{"orderId":"saison-order-001","amount":"1000","cardAmount":"700","pointAmount":"300","payNowIdParam":{"token":"test-token"}}`)
+	assert.Equal(t, http.StatusOK, code, paymentRes.Err)
+	assert.Equal(t, "1000", paymentRes.Result.ReqAmount)
+
+	code, _ = postPayment(t, handler, "/payment/saison/capture", `{"orderId":"saison-order-001"}`)
+	assert.Equal(t, http.StatusOK, code)
+
+	// the search shows the split of the card and the points
+	req := httptest.NewRequest(http.MethodPost, "/payment/search", bytes.NewBufferString(`{"orderId":"saison-order-001","serviceTypes":["saison"]}`))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var searchRes endpoint.SearchResponse
+	err := json.Unmarshal(rec.Body.Bytes(), &searchRes)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(searchRes.Orders))
+	assert.Equal(t, "Capture", searchRes.Orders[0].LastSuccessTxnType)
+	assert.Equal(t, "700", searchRes.Orders[0].ProperOrderInfo.CardAmount)
+	assert.Equal(t, "300", searchRes.Orders[0].ProperOrderInfo.PointAmount)
+
+	code, _ = postPayment(t, handler, "/payment/saison/cancel", `{"orderId":"saison-order-001"}`)
+	assert.Equal(t, http.StatusOK, code)
+
+	// reject the amount which is not the sum of the split
+	code, _ = postPayment(t, handler, "/payment/saison/authorize",
+		`{"orderId":"saison-order-002","amount":"1000","cardAmount":"800","pointAmount":"300","payNowIdParam":{"token":"test-token"}}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	assert.Equal(t, []string{"Authorize/saison", "Capture/saison", "Search/search", "Cancel/saison"}, fake.Requests())
+}
+
+// TestGRPCSaison function
+func TestGRPCSaison(t *testing.T) {
+	fake := veritranstest.NewServer("test-ccid", "test-password")
+	defer fake.Close()
+	_, server := getFakeServers(fake)
+	ctx := context.Background()
+	serviceType := veritrans.PaymentServiceTypes[veritrans.Saison]
+
+	_, err := server.Authorize(ctx, &pb.PaymentRequest{
+		ServiceType: &serviceType,
+		ServiceParams: &pb.PaymentRequest_Saison{Saison: &pb.SaisonPaymentRequest{
+			OrderID:       "saison-order-003",
+			Amount:        "1000",
+			CardAmount:    "0",
+			PointAmount:   "1000",
+			PayNowIDParam: &pb.PaymentRequest_PayNowIDParam{Token: "test-token"},
+		}},
+	})
+	assert.Nil(t, err)
+
+	reply, err := server.SearchOrders(ctx, &pb.SearchRequest{
+		ServiceTypes: []string{veritrans.PaymentServiceTypes[veritrans.Saison]},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(reply.OrderInfo))
+	assert.Equal(t, "1000", reply.OrderInfo[0].ProperOrderInfo.PointAmount)
+
+	_, err = server.Capture(ctx, &pb.PaymentRequest{ServiceType: &serviceType, OrderID: "saison-order-003"})
+	assert.Nil(t, err)
+
+	_, err = server.Cancel(ctx, &pb.PaymentRequest{ServiceType: &serviceType})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// TestGRPCWithCapture function
+func TestGRPCWithCapture(t *testing.T) {
+	fake := veritranstest.NewServer("test-ccid", "test-password")
+	defer fake.Close()
+	_, server := getFakeServers(fake)
+	ctx := context.Background()
+	withCapture := "true"
+	serviceType := veritrans.PaymentServiceTypes[veritrans.Saison]
+
+	_, err := server.Authorize(ctx, &pb.PaymentRequest{
+		OrderID:       "capture-order-001",
+		Amount:        "1000",
+		WithCapture:   &withCapture,
+		PayNowIDParam: &pb.PaymentRequest_PayNowIDParam{Token: "test-token"},
+	})
+	assert.Nil(t, err)
+
+	_, err = server.Authorize(ctx, &pb.PaymentRequest{
+		ServiceType: &serviceType,
+		ServiceParams: &pb.PaymentRequest_Saison{Saison: &pb.SaisonPaymentRequest{
+			OrderID:       "capture-order-002",
+			Amount:        "1000",
+			CardAmount:    "700",
+			PointAmount:   "300",
+			WithCapture:   &withCapture,
+			PayNowIDParam: &pb.PaymentRequest_PayNowIDParam{Token: "test-token"},
+		}},
+	})
+	assert.Nil(t, err)
+
+	// both authorizations are requested with the capture
+	reply, err := server.SearchOrders(ctx, &pb.SearchRequest{
+		ServiceTypes: []string{veritrans.PaymentServiceTypes[veritrans.PayCard], veritrans.PaymentServiceTypes[veritrans.Saison]},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(reply.OrderInfo))
+	for _, orderInfo := range reply.OrderInfo {
+		assert.Equal(t, withCapture, orderInfo.TransactionInfo[0].ReqWithCapture, orderInfo.OrderID)
+	}
+}
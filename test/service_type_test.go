@@ -0,0 +1,89 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/david1992121/veritrans-microservice/api/pb"
+	"github.com/david1992121/veritrans-microservice/internal/veritrans"
+	"github.com/david1992121/veritrans-microservice/internal/veritrans/veritranstest"
+	assert "github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestHTTPServiceType function
+func TestHTTPServiceType(t *testing.T) {
+	fake := veritranstest.NewServer("test-ccid", "test-password")
+	defer fake.Close()
+	handler, _ := getFakeServers(fake, "card", "em")
+
+	// the card is used without the service type
+	code, paymentRes := postPayment(t, handler, "/authorize", `{"orderId":"type-order-001","amount":"1000","payNowIdParam":{"token":"test-token"}}`)
+	assert.Equal(t, http.StatusOK, code, paymentRes.Err)
+	assert.Equal(t, veritrans.PaymentServiceTypes[veritrans.PayCard], paymentRes.Result.ServiceType)
+
+	// the params and the result vary by the service type
+	code, paymentRes = postPayment(t, handler, "/authorize", `{"serviceType":"em","orderId":"type-order-002","amount":"1000","serviceOptionType":"suica-mobile-app"}`)
+	assert.Equal(t, http.StatusOK, code, paymentRes.Err)
+	assert.NotEmpty(t, paymentRes.Result.AppURL)
+
+	code, _ = postPayment(t, handler, "/cancel", `{"serviceType":"em","orderId":"type-order-002"}`)
+	assert.Equal(t, http.StatusOK, code)
+
+	// reject the unknown, disabled and unsupported services
+	code, paymentRes = postPayment(t, handler, "/authorize", `{"serviceType":"bitcoin","orderId":"type-order-003","amount":"1000"}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Contains(t, paymentRes.Err, "serviceType")
+
+	code, paymentRes = postPayment(t, handler, "/cancel", `{"serviceType":"saison","orderId":"type-order-003"}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Contains(t, paymentRes.Err, "saison is not enabled")
+
+	code, paymentRes = postPayment(t, handler, "/capture", `{"serviceType":"em","orderId":"type-order-002"}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Contains(t, paymentRes.Err, "capture is not supported by em")
+
+	assert.Equal(t, []string{"Authorize/card", "Authorize/em", "Cancel/em"}, fake.Requests())
+}
+
+// TestGRPCServiceType function
+func TestGRPCServiceType(t *testing.T) {
+	fake := veritranstest.NewServer("test-ccid", "test-password")
+	defer fake.Close()
+	_, server := getFakeServers(fake, "em", "saison")
+	ctx := context.Background()
+
+	serviceType := veritrans.PaymentServiceTypes[veritrans.EM]
+	reply, err := server.Authorize(ctx, &pb.PaymentRequest{
+		ServiceType: &serviceType,
+		ServiceParams: &pb.PaymentRequest_Em{Em: &pb.EMPaymentRequest{
+			OrderID:           "type-order-004",
+			Amount:            "1000",
+			ServiceOptionType: veritrans.EMServiceOptions[veritrans.SuicaMobileApp],
+		}},
+	})
+	assert.Nil(t, err)
+	assert.NotEmpty(t, reply.Result.GetAppURL())
+
+	serviceType = veritrans.PaymentServiceTypes[veritrans.Saison]
+	_, err = server.Authorize(ctx, &pb.PaymentRequest{
+		ServiceType: &serviceType,
+		ServiceParams: &pb.PaymentRequest_Saison{Saison: &pb.SaisonPaymentRequest{
+			OrderID:       "type-order-005",
+			Amount:        "1000",
+			CardAmount:    "500",
+			PointAmount:   "500",
+			PayNowIDParam: &pb.PaymentRequest_PayNowIDParam{Token: "test-token"},
+		}},
+	})
+	assert.Nil(t, err)
+
+	_, err = server.Capture(ctx, &pb.PaymentRequest{ServiceType: &serviceType, OrderID: "type-order-005"})
+	assert.Nil(t, err)
+
+	// the card is not enabled
+	_, err = server.Cancel(ctx, &pb.PaymentRequest{OrderID: "type-order-005"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
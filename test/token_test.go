@@ -0,0 +1,91 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/david1992121/veritrans-microservice/api/pb"
+	"github.com/david1992121/veritrans-microservice/internal/veritrans"
+	"github.com/david1992121/veritrans-microservice/internal/veritrans/veritranstest"
+	"github.com/david1992121/veritrans-microservice/pkg/endpoint"
+	assert "github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestHTTPPayWithToken function
+func TestHTTPPayWithToken(t *testing.T) {
+	fake := veritranstest.NewServer("test-ccid", "test-password")
+	defer fake.Close()
+	handler, _ := getFakeServers(fake)
+
+	code, paymentRes := postPayment(t, handler, "/payment/card/pay-with-token", `{"orderId":"token-order-001","amount":"1000","token":"test-token","withCapture":"true"}`)
+	assert.Equal(t, http.StatusOK, code, paymentRes.Err)
+	assert.Equal(t, veritrans.PaymentServiceTypes[veritrans.PayCard], paymentRes.Result.ServiceType)
+
+	// the card is saved to the account in the same request
+	code, paymentRes = postPayment(t, handler, "/payment/card/pay-with-token", `{"orderId":"token-order-002","amount":"2000","token":"test-token","accountId":"test-account"}`)
+	assert.Equal(t, http.StatusOK, code, paymentRes.Err)
+
+	req := httptest.NewRequest(http.MethodPost, "/payment/search", bytes.NewBufferString(`{"serviceTypes":["card"]}`))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var searchRes endpoint.SearchResponse
+	err := json.Unmarshal(rec.Body.Bytes(), &searchRes)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(searchRes.Orders))
+	assert.Equal(t, "", searchRes.Orders[0].AccountID)
+	assert.Equal(t, "true", searchRes.Orders[0].TransactionInfos.TransactionInfo[0].ProperInfo.ReqWithCapture)
+	assert.Equal(t, "test-account", searchRes.Orders[1].AccountID)
+
+	code, paymentRes = postPayment(t, handler, "/payment/card/pay-with-token", `{"orderId":"token-order-003","amount":"1000"}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Contains(t, paymentRes.Err, "token")
+
+	assert.Equal(t, []string{"Authorize/card", "Authorize/card", "Search/search"}, fake.Requests())
+}
+
+// TestGRPCPayWithToken function
+func TestGRPCPayWithToken(t *testing.T) {
+	fake := veritranstest.NewServer("test-ccid", "test-password")
+	defer fake.Close()
+	_, server := getFakeServers(fake)
+	ctx := context.Background()
+
+	accountID := "test-account"
+	reply, err := server.PayWithToken(ctx, &pb.TokenPaymentRequest{
+		OrderID:       "token-order-004",
+		Amount:        "1000",
+		Token:         "test-token",
+		AccountID:     &accountID,
+		PaymentOption: &pb.PaymentOption{Type: "lumpSum"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "1000", reply.Result.Amount)
+
+	// the nested token of the payment request is decoded as well
+	withCapture := "true"
+	_, err = server.Authorize(ctx, &pb.PaymentRequest{
+		OrderID:       "token-order-005",
+		Amount:        "3000",
+		WithCapture:   &withCapture,
+		PayNowIDParam: &pb.PaymentRequest_PayNowIDParam{Token: "test-token"},
+	})
+	assert.Nil(t, err)
+
+	_, err = server.PayWithToken(ctx, &pb.TokenPaymentRequest{OrderID: "token-order-006", Amount: "1000"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	searchReply, err := server.SearchOrders(ctx, &pb.SearchRequest{ServiceTypes: []string{"card"}})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(searchReply.OrderInfo))
+	assert.Equal(t, "test-account", searchReply.OrderInfo[0].AccountID)
+	assert.Equal(t, "10", searchReply.OrderInfo[0].TransactionInfo[0].ReqJpoInformation)
+	assert.Equal(t, "true", searchReply.OrderInfo[1].TransactionInfo[0].ReqWithCapture)
+}